@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+data "bpkio_categories" "all" {}
+
+output "all_categories" {
+  value = data.bpkio_categories.all
+}
+
+data "bpkio_categories" "sports" {
+  name = "sports"
+}
+
+output "sports_subcategories" {
+  value = one(data.bpkio_categories.sports.categories[*].subcategories)
+}
@@ -17,3 +17,9 @@ data "bpkio_service_ad_insertion" "this" {
 output "service_output" {
   value = data.bpkio_service_ad_insertion.this
 }
+
+# Look up by name instead of ID. The lookup fails unless exactly one ad
+# insertion service has this name.
+data "bpkio_service_ad_insertion" "by_name" {
+  name = "my-ad-insertion"
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+data "bpkio_service_content_replacement" "this" {
+  id = 53827
+}
+
+output "this_replacement" {
+  value = data.bpkio_service_content_replacement.this.replacement
+}
@@ -31,10 +31,29 @@ output "content-replacement" {
   value = data.bpkio_services.content-replacement
 }
 
-data "bpkio_services" "disabled" {
-  state = "disabled"
+data "bpkio_services" "paused" {
+  state = "paused"
 }
 
-output "disabled" {
-  value = data.bpkio_services.disabled
+output "paused" {
+  value = data.bpkio_services.paused
+}
+
+# Every paused service tagged prod, changed in the last release window.
+data "bpkio_services" "paused_prod" {
+  state         = "paused"
+  tags_all      = ["prod"]
+  name_regex    = "^news-"
+  updated_after = "2025-01-01T00:00:00Z"
+}
+
+output "paused_prod_urls" {
+  value = data.bpkio_services.paused_prod.services[*].url
+}
+
+# Large tenants: list at most 50 services. A warning is returned when more
+# match.
+data "bpkio_services" "first_enabled" {
+  state       = "enabled"
+  max_results = 50
 }
@@ -16,3 +16,9 @@ data "bpkio_source_ad_server" "this" {
 output "this_source" {
   value = data.bpkio_source_ad_server.this
 }
+
+# Look up by name instead of ID. The lookup fails unless exactly one object
+# has this name.
+data "bpkio_source_ad_server" "by_name" {
+  name = "my-ad-server"
+}
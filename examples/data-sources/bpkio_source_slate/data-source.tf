@@ -16,3 +16,9 @@ data "bpkio_source_slate" "this" {
 output "this_source" {
   value = data.bpkio_source_slate.this
 }
+
+# Look up by name instead of ID. The lookup fails unless exactly one object
+# has this name.
+data "bpkio_source_slate" "by_name" {
+  name = "my-slate"
+}
@@ -21,3 +21,15 @@ data "bpkio_sources" "slates" {
 output "slates" {
   value = data.bpkio_sources.slates
 }
+
+# HLS live sources of the team, sorted by name, e.g. to drive for_each.
+data "bpkio_sources" "team_hls" {
+  type       = "live"
+  name_regex = "^team-a-"
+  format     = "hls"
+  sort_by    = "name"
+}
+
+output "team_hls_urls" {
+  value = { for s in data.bpkio_sources.team_hls.sources : s.name => s.url }
+}
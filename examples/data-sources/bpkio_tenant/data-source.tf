@@ -0,0 +1,13 @@
+data "bpkio_tenant" "current" {}
+
+# Refuse to apply against the wrong tenant.
+check "tenant" {
+  assert {
+    condition     = data.bpkio_tenant.current.name == "my-production-tenant"
+    error_message = "The API key does not belong to the expected tenant."
+  }
+}
+
+output "tenant_owner" {
+  value = data.bpkio_tenant.current.owner.email
+}
@@ -16,3 +16,26 @@ data "bpkio_transcoding_profile" "this" {
 output "this_transcoding_profile" {
   value = data.bpkio_transcoding_profile.this
 }
+
+# Fail early when the profile does not carry the 720p rendition the source
+# ladder expects.
+data "bpkio_transcoding_profile" "checked" {
+  id = 4694
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.ladder.video[*].resolution, "1280x720")
+      error_message = "The transcoding profile has no 1280x720 rendition."
+    }
+  }
+}
+
+output "video_bitrates" {
+  value = data.bpkio_transcoding_profile.checked.ladder.video[*].bitrate
+}
+
+# Look up by name instead of ID. The lookup fails unless exactly one object
+# has this name.
+data "bpkio_transcoding_profile" "by_name" {
+  name = "h264-720p"
+}
@@ -0,0 +1,2 @@
+# Category can be imported by specifying the numeric identifier.
+terraform import bpkio_category.example 123
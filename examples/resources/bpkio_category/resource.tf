@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_category" "sports" {
+  name = "sports"
+
+  subcategories = [
+    { key = "league", value = "premier-league" },
+    { key = "league", value = "la-liga" },
+    { key = "audience", value = "uk" },
+  ]
+}
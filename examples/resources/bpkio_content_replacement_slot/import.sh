@@ -0,0 +1,2 @@
+# Content Replacement Slot can be imported by specifying the service and slot identifiers.
+terraform import bpkio_content_replacement_slot.example 123/456
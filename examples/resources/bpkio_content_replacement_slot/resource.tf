@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_live" "this" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_slate" "blackout" {
+  name = "foobar-test-tf-blackout"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+}
+
+resource "bpkio_service_content_replacement" "this" {
+  name = "foobar-test-tf-blackout"
+
+  source = {
+    id = bpkio_source_live.this.id
+  }
+
+  replacement = {
+    id = bpkio_source_slate.blackout.id
+  }
+}
+
+# Black out a fixture that is not licensed for streaming.
+resource "bpkio_content_replacement_slot" "fixture" {
+  service_id = bpkio_service_content_replacement.this.id
+  name       = "Saturday fixture"
+  start_time = "2030-05-04T15:00:00+01:00"
+  end_time   = "2030-05-04T17:00:00+01:00"
+
+  replacement = {
+    id = bpkio_source_slate.blackout.id
+  }
+}
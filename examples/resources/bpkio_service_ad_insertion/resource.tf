@@ -150,6 +150,40 @@ resource "bpkio_service_ad_insertion" "test_free" {
   ]
 }
 
+resource "bpkio_source_asset" "this" {
+  name = "foobar-test-tf-asset"
+  url  = "https://vod.stream/master.m3u8"
+}
+
+resource "bpkio_service_ad_insertion" "this_vod" {
+  name = "foobar-test-tf-vod"
+
+  source = {
+    id = bpkio_source_asset.this.id
+  }
+
+  vod_ad_insertion = {
+    ad_server = {
+      id = bpkio_source_adserver.this.id
+    }
+
+    pre_roll = {
+      max_duration = 30
+    }
+
+    mid_roll = {
+      positions    = [300, 900]
+      max_duration = 60
+    }
+
+    post_roll = {}
+  }
+
+  transcoding_profile = {
+    id = data.bpkio_transcoding_profile.this.id
+  }
+}
+
 
 data "bpkio_service_ad_insertion" "this" {
   id = bpkio_service_ad_insertion.this.id
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_live" "this" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_slate" "blackout" {
+  name = "foobar-test-tf-blackout"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+}
+
+data "bpkio_transcoding_profile" "this" {
+  id = 4694
+}
+
+resource "bpkio_service_content_replacement" "this" {
+  name = "foobar-test-tf-blackout"
+  tags = ["sports", "blackout"]
+
+  source = {
+    id = bpkio_source_live.this.id
+  }
+
+  replacement = {
+    id = bpkio_source_slate.blackout.id
+  }
+
+  transcoding_profile = {
+    id = data.bpkio_transcoding_profile.this.id
+  }
+}
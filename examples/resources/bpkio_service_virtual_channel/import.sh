@@ -0,0 +1,2 @@
+# Virtual Channel Service can be imported by specifying the numeric identifier.
+terraform import bpkio_service_virtual_channel.example 123
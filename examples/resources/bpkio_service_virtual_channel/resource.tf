@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_live" "this" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_slate" "this" {
+  name = "foobar-test-tf-slate"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+}
+
+resource "bpkio_source_adserver" "this" {
+  name = "foobar-test-tf-adserver"
+  url  = "https://ads.example.com/vast"
+}
+
+data "bpkio_transcoding_profile" "this" {
+  id = 4694
+}
+
+resource "bpkio_service_virtual_channel" "this" {
+  name = "foobar-test-tf-channel"
+  tags = ["linear"]
+
+  base_live = {
+    id = bpkio_source_live.this.id
+  }
+
+  default_slate = {
+    id = bpkio_source_slate.this.id
+  }
+
+  ad_break_insertion = {
+    ad_server = {
+      id = bpkio_source_adserver.this.id
+    }
+    gap_filler = {
+      id = bpkio_source_slate.this.id
+    }
+  }
+
+  transcoding_profile = {
+    id = data.bpkio_transcoding_profile.this.id
+  }
+
+  server_side_ad_tracking = {
+    enable = true
+  }
+}
@@ -0,0 +1,16 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_asset" "this" {
+  name        = "foobar-test-tf"
+  description = "test asset"
+  url         = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8"
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_asset_catalog" "this" {
+  name         = "foobar-test-tf"
+  description  = "test asset catalog"
+  url          = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/"
+  asset_sample = "bbb/bbb.m3u8"
+}
@@ -16,6 +16,12 @@ resource "bpkio_source_live" "this" {
 
   //TODO: Find way to handle when origin is empty
   origin = {}
+
+  # Broadpeak validates the source URL on create; give up after 5 minutes
+  # instead of the default 10.
+  timeouts {
+    create = "5m"
+  }
 }
 
 resource "bpkio_source_slate" "this" {
@@ -0,0 +1,2 @@
+# Transcoding Profile can be imported by specifying the numeric identifier.
+terraform import bpkio_transcoding_profile.example 123
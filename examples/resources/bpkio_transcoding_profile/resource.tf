@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+# Keep the ladder in a JSON file of its own, so that changes to it can be
+# reviewed like any other code.
+resource "bpkio_transcoding_profile" "h264" {
+  name    = "h264-720p"
+  content = file("${path.module}/h264-720p.json")
+}
+
+resource "bpkio_source_live" "this" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_adserver" "this" {
+  name = "foobar-test-tf-adserver"
+  url  = "https://ads.example.com/vast"
+}
+
+resource "bpkio_service_ad_insertion" "this" {
+  name = "foobar-test-tf-ad-insertion"
+
+  source = {
+    id = bpkio_source_live.this.id
+  }
+
+  live_ad_replacement = {
+    ad_server = {
+      id = bpkio_source_adserver.this.id
+    }
+  }
+
+  enable_ad_transcoding = true
+
+  transcoding_profile = {
+    id = bpkio_transcoding_profile.h264.id
+  }
+}
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_live" "base" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_asset" "this" {
+  for_each = toset(["news", "movie"])
+
+  name = "foobar-test-tf-${each.key}"
+  url  = "https://vod.stream/${each.key}/master.m3u8"
+}
+
+resource "bpkio_service_virtual_channel" "this" {
+  name = "foobar-test-tf-channel"
+
+  base_live = {
+    id = bpkio_source_live.base.id
+  }
+}
+
+# The programming of a week, generated from a CSV export. Slots of the week
+# that are not listed are deleted on apply.
+resource "bpkio_virtual_channel_schedule" "week" {
+  service_id   = bpkio_service_virtual_channel.this.id
+  window_start = "2030-01-07T00:00:00+01:00"
+  window_end   = "2030-01-14T00:00:00+01:00"
+
+  slots = [
+    for slot in csvdecode(file("${path.module}/schedule.csv")) : {
+      name       = slot.name
+      start_time = slot.start_time
+      duration   = tonumber(slot.duration)
+      source     = { id = bpkio_source_asset.this[slot.asset].id }
+    }
+  ]
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    bpkio = {
+      source = "bashou/bpkio"
+    }
+  }
+}
+
+provider "bpkio" {
+}
+
+resource "bpkio_source_live" "base" {
+  name = "foobar-test-tf-live"
+  url  = "https://live.stream/master.m3u8"
+}
+
+resource "bpkio_source_asset" "movie" {
+  name = "foobar-test-tf-movie"
+  url  = "https://vod.stream/movie/master.m3u8"
+}
+
+resource "bpkio_service_virtual_channel" "this" {
+  name = "foobar-test-tf-channel"
+
+  base_live = {
+    id = bpkio_source_live.base.id
+  }
+}
+
+resource "bpkio_virtual_channel_slot" "this" {
+  service_id = bpkio_service_virtual_channel.this.id
+  name       = "Friday movie"
+  start_time = "2030-01-04T20:00:00+01:00"
+  duration   = 5400
+
+  source = {
+    id = bpkio_source_asset.movie.id
+  }
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &categoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &categoriesDataSource{}
+)
+
+// categoriesDataSource is the data source implementation.
+type categoriesDataSource struct {
+	client *bpkioClient
+}
+
+// NewCategoriesDataSource is a helper function to simplify the provider implementation.
+func NewCategoriesDataSource() datasource.DataSource {
+	return &categoriesDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *categoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *categoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_categories"
+}
+
+// Schema defines the schema for the data source.
+func (d *categoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the categories of the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the category with this name.",
+			},
+			"max_results": maxResultsAttribute("categories", "in API order"),
+			"categories": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Categories of the tenant, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"subcategories": schema.SetNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Computed: true,
+									},
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *categoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state categoriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, status, err := listAll(ctx, d.client.pageSize, int(state.MaxResults.ValueInt64()), d.client.GetAllCategories, func(c broadpeakio.CategoryOutput) bool {
+		return state.Name.IsNull() || c.Name == state.Name.ValueString()
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Categories",
+			err.Error(),
+		)
+		return
+	}
+	addListWarnings(&resp.Diagnostics, "categories", state.MaxResults, status)
+
+	state.Categories = flattenCategories(categories, state.Name)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// flattenCategories maps the categories returned by the API, keeping only the
+// one named name when it is set.
+func flattenCategories(categories []broadpeakio.CategoryOutput, name types.String) []categoryModel {
+	result := []categoryModel{}
+	for _, c := range categories {
+		if !name.IsNull() && c.Name != name.ValueString() {
+			continue
+		}
+		result = append(result, flattenCategory(c))
+	}
+	return result
+}
+
+// categoriesDataSourceModel maps the data source schema data.
+type categoriesDataSourceModel struct {
+	Name       types.String    `tfsdk:"name"`
+	MaxResults types.Int64     `tfsdk:"max_results"`
+	Categories []categoryModel `tfsdk:"categories"`
+}
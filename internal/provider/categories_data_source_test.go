@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenCategories(t *testing.T) {
+	in := []broadpeakio.CategoryOutput{
+		{Id: 1, Name: "sports", Subcategory: []broadpeakio.Subcategory{{Key: "league", Value: "premier-league"}}},
+		{Id: 2, Name: "news"},
+	}
+
+	all := flattenCategories(in, types.StringNull())
+	assert.Len(t, all, 2)
+	assert.Equal(t, "premier-league", all[0].Subcategories[0].Value.ValueString())
+	assert.NotNil(t, all[1].Subcategories)
+
+	named := flattenCategories(in, types.StringValue("news"))
+	assert.Len(t, named, 1)
+	assert.Equal(t, int64(2), named[0].ID.ValueInt64())
+
+	assert.NotNil(t, flattenCategories(in, types.StringValue("missing")))
+}
+
+func TestAccCategoriesDataSource_Name(t *testing.T) {
+	apiKey := testAccAPIKey()
+	dataSourceName := "data.bpkio_categories.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryConfig(apiKey, "tf-acc-categories", `
+  subcategories = [
+    { key = "genre", value = "news" },
+  ]
+`) + `
+data "bpkio_categories" "test" {
+  name = bpkio_category.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "categories.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "categories.0.id", "bpkio_category.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "categories.0.subcategories.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "categories.0.subcategories.*", map[string]string{
+						"key":   "genre",
+						"value": "news",
+					}),
+				),
+			},
+		},
+	})
+}
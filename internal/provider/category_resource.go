@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &categoryResource{}
+	_ resource.ResourceWithConfigure   = &categoryResource{}
+	_ resource.ResourceWithImportState = &categoryResource{}
+)
+
+// NewCategoryResource is a helper function to simplify the provider implementation.
+func NewCategoryResource() resource.Resource {
+	return &categoryResource{}
+}
+
+// categoryResource is the resource implementation.
+type categoryResource struct {
+	client *bpkioClient
+}
+
+// categoryAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var categoryAPIFields = map[string]path.Path{
+	"name":          path.Root("name"),
+	"subcategories": path.Root("subcategories"),
+}
+
+// subcategoryAttrTypes are the attribute types of a subcategory object.
+var subcategoryAttrTypes = map[string]attr.Type{
+	"key":   types.StringType,
+	"value": types.StringType,
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *categoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *categoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *categoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a category, a named group of key/value subcategories used to target slots and ad decisioning.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the category.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the category, unique within the tenant.",
+			},
+			"subcategories": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Key of the subcategory, e.g. `league`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "Value of the subcategory, e.g. `premier-league`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: subcategoryAttrTypes}, []attr.Value{})),
+				Description: "Key/value pairs of the category. Defaults to none.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *categoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan categoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new category
+	category, err := r.client.CreateCategory(ctx, expandCategory(plan.categoryModel))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating category", "Could not create category", err, categoryAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, categoryResourceModel{flattenCategory(category), plan.Timeouts})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state categoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetCategory(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Category no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Category",
+			fmt.Sprintf("Could not read category ID %d: %s", state.ID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, categoryResourceModel{flattenCategory(category), state.Timeouts})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *categoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan categoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID := uint(plan.ID.ValueInt64())
+
+	// Update existing category
+	category, err := r.client.UpdateCategory(ctx, categoryID, expandCategory(plan.categoryModel))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating category", fmt.Sprintf("Could not update category ID %d", categoryID), err, categoryAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, categoryResourceModel{flattenCategory(category), plan.Timeouts})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state categoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing category
+	_, err := r.client.DeleteCategory(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Category",
+			"Could not delete category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *categoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing category",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandCategory builds the API request for a category from its model.
+func expandCategory(m categoryModel) categoryInput {
+	in := categoryInput{
+		Category:      broadpeakio.Category{Name: m.Name.ValueString()},
+		Subcategories: make([]broadpeakio.Subcategory, 0, len(m.Subcategories)),
+	}
+	for _, sub := range m.Subcategories {
+		in.Subcategories = append(in.Subcategories, broadpeakio.Subcategory{
+			Key:   sub.Key.ValueString(),
+			Value: sub.Value.ValueString(),
+		})
+	}
+	return in
+}
+
+// flattenCategory maps a category returned by the API to its model.
+func flattenCategory(c broadpeakio.CategoryOutput) categoryModel {
+	m := categoryModel{
+		ID:            types.Int64Value(int64(c.Id)),
+		Name:          types.StringValue(c.Name),
+		Subcategories: make([]subcategoryModel, 0, len(c.Subcategory)),
+	}
+	for _, sub := range c.Subcategory {
+		m.Subcategories = append(m.Subcategories, subcategoryModel{
+			Key:   types.StringValue(sub.Key),
+			Value: types.StringValue(sub.Value),
+		})
+	}
+	return m
+}
+
+// categoryResourceModel is the state of a category resource: the category
+// plus the operation timeouts.
+type categoryResourceModel struct {
+	categoryModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// categoryModel maps the category schema data. It is shared by the resource
+// and the entries of the categories data source.
+type categoryModel struct {
+	ID            types.Int64        `tfsdk:"id"`
+	Name          types.String       `tfsdk:"name"`
+	Subcategories []subcategoryModel `tfsdk:"subcategories"`
+}
+
+// subcategoryModel maps a key/value pair of a category.
+type subcategoryModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
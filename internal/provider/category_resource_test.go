@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCategory(t *testing.T) {
+	// Removing the last subcategory must send an empty list, not omit it.
+	body, err := json.Marshal(expandCategory(categoryModel{Name: types.StringValue("sports")}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"sports","subcategories":[]}`, string(body))
+
+	body, err = json.Marshal(expandCategory(categoryModel{
+		Name: types.StringValue("sports"),
+		Subcategories: []subcategoryModel{
+			{Key: types.StringValue("league"), Value: types.StringValue("premier-league")},
+		},
+	}))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"sports","subcategories":[{"key":"league","value":"premier-league"}]}`, string(body))
+}
+
+func TestAccCategory_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_category.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryConfig(apiKey, "tf-acc-category", `
+  subcategories = [
+    { key = "league", value = "premier-league" },
+    { key = "league", value = "la-liga" },
+  ]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-category"),
+					resource.TestCheckResourceAttr(resourceName, "subcategories.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subcategories.*", map[string]string{
+						"key":   "league",
+						"value": "la-liga",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Reordering the subcategories is not a change.
+				Config: testAccCategoryConfig(apiKey, "tf-acc-category", `
+  subcategories = [
+    { key = "league", value = "la-liga" },
+    { key = "league", value = "premier-league" },
+  ]
+`),
+				PlanOnly: true,
+			},
+			{
+				// Rename and drop the subcategories, in place.
+				Config: testAccCategoryConfig(apiKey, "tf-acc-category-updated", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-category-updated"),
+					resource.TestCheckResourceAttr(resourceName, "subcategories.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCategory_InvalidSubcategory(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryConfig(apiKey, "tf-acc-category", `
+  subcategories = [
+    { key = "", value = "premier-league" },
+  ]
+`),
+				ExpectError: regexp.MustCompile(`string length must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccCategory_DuplicateName(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The fake API is seeded with a "sports" category.
+				Config:      testAccCategoryConfig(apiKey, "sports", ""),
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+		},
+	})
+}
+
+func TestAccCategory_Drift(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_category.test"
+	config := testAccCategoryConfig(apiKey, "tf-acc-category", `
+  subcategories = [
+    { key = "league", value = "premier-league" },
+  ]
+`)
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				// Subcategories edited outside of Terraform are put back.
+				PreConfig: func() {
+					testAccFakeAPI.SetCategoryField(id, "subcategories", []any{
+						fakeObject{"key": "league", "value": "serie-a"},
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "subcategories.0.value", "premier-league"),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteCategory(id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+// testAccCategoryConfig declares a category; extra is added to its body.
+func testAccCategoryConfig(apiKey, name, extra string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_category" "test" {
+  name = "%s"
+%s
+}
+`, apiKey, name, extra)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultEndpoint is the Broadpeak API used when no endpoint is configured.
+const defaultEndpoint = "https://api.broadpeak.io"
+
+// bpkioClient is the Broadpeak API client shared by resources and data
+// sources. It mirrors the method set of broadpeakio.BroadpeakClient and reuses
+// the SDK request and response models, but sends every call to the configured
+// endpoint instead of the SDK's hard-coded production URL.
+type bpkioClient struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+	// pageSize is the number of items requested per page of a listing.
+	pageSize int
+	// lists caches the full-tenant listings for the lifetime of the client.
+	lists *listCache
+}
+
+// clientOptions holds the optional settings of a bpkioClient.
+type clientOptions struct {
+	retry             retryPolicy
+	maxConcurrent     int
+	requestsPerSecond float64
+	pageSize          int
+}
+
+// clientOption customizes a bpkioClient created by newBpkioClient.
+type clientOption func(*clientOptions)
+
+// withRetryPolicy overrides defaultRetryPolicy.
+func withRetryPolicy(policy retryPolicy) clientOption {
+	return func(o *clientOptions) {
+		o.retry = policy
+	}
+}
+
+// withConcurrencyLimit caps the number of requests in flight at once.
+func withConcurrencyLimit(n int) clientOption {
+	return func(o *clientOptions) {
+		o.maxConcurrent = n
+	}
+}
+
+// withRequestsPerSecond caps the rate at which requests are sent. A rate of
+// zero leaves it uncapped.
+func withRequestsPerSecond(rate float64) clientOption {
+	return func(o *clientOptions) {
+		o.requestsPerSecond = rate
+	}
+}
+
+// withPageSize overrides defaultPageSize.
+func withPageSize(n int) clientOption {
+	return func(o *clientOptions) {
+		o.pageSize = n
+	}
+}
+
+// newBpkioClient returns a client for the API served at endpoint.
+func newBpkioClient(endpoint, apiKey string, opts ...clientOption) (*bpkioClient, error) {
+	baseURL, err := parseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	options := clientOptions{
+		retry:         defaultRetryPolicy,
+		maxConcurrent: defaultMaxConcurrentRequests,
+		pageSize:      defaultPageSize,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Every attempt of a retried request goes through the limits again.
+	limited := newLimitTransport(http.DefaultTransport, options.maxConcurrent, options.requestsPerSecond)
+
+	return &bpkioClient{
+		baseURL: baseURL,
+		apiKey:  apiKey,
+		httpClient: &http.Client{
+			Transport: &retryTransport{next: limited, policy: options.retry},
+		},
+		pageSize: options.pageSize,
+		lists:    newListCache(),
+	}, nil
+}
+
+// parseEndpoint validates an API endpoint and returns the versioned base URL
+// requests are resolved against. The endpoint may point at the API root
+// (https://api.broadpeak.io), at its /v1 prefix, or at a path-based proxy.
+func parseEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("the URL scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return "", errors.New("the URL must include a host")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", errors.New("the URL must not include a query string or fragment")
+	}
+
+	p := strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(p, "/v1") {
+		p += "/v1"
+	}
+	u.Path = p + "/"
+	u.RawPath = ""
+
+	return u.String(), nil
+}
+
+// get sends a GET request and decodes the response into out.
+func (c *bpkioClient) get(ctx context.Context, path string, out any) error {
+	_, err := c.do(ctx, http.MethodGet, path, nil, out)
+	return err
+}
+
+// getList is get for full-tenant list calls, read through the list cache.
+func (c *bpkioClient) getList(ctx context.Context, path string, out any) error {
+	body, err := c.lists.get(ctx, c.baseURL+path, func() ([]byte, error) {
+		data, err := c.do(ctx, http.MethodGet, path, nil, nil)
+		return []byte(data), err
+	})
+	if err != nil {
+		return interrupted(ctx, http.MethodGet, path, err)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("decoding response body: %w", err)
+	}
+	return nil
+}
+
+// post sends in as a JSON POST request and decodes the response into out.
+func (c *bpkioClient) post(ctx context.Context, path string, in, out any) error {
+	_, err := c.do(ctx, http.MethodPost, path, in, out)
+	return err
+}
+
+// put sends in as a JSON PUT request and decodes the response into out.
+func (c *bpkioClient) put(ctx context.Context, path string, in, out any) error {
+	_, err := c.do(ctx, http.MethodPut, path, in, out)
+	return err
+}
+
+// delete sends a DELETE request and returns the raw response body.
+func (c *bpkioClient) delete(ctx context.Context, path string) (string, error) {
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// do performs a request against the API. Non-2xx responses are returned as
+// errors carrying the HTTP status and the response body.
+func (c *bpkioClient) do(ctx context.Context, method, path string, in, out any) (string, error) {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return "", fmt.Errorf("encoding request body: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	// A write, even a failed one, may change what the listings return.
+	if method != http.MethodGet {
+		defer c.lists.invalidate()
+	}
+
+	// The fields of the operation, such as tf_resource_type and tf_req_id,
+	// are carried by ctx into every log of the request.
+	ctx = tflog.SetField(ctx, "method", method)
+	ctx = tflog.SetField(ctx, "path", path)
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	tflog.Debug(ctx, "Sending bpkio API request")
+	start := time.Now()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		err = interrupted(ctx, method, path, err)
+		tflog.Debug(ctx, "Request to bpkio API failed", map[string]interface{}{"error": err.Error()})
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", interrupted(ctx, method, path, fmt.Errorf("reading response body: %w", err))
+	}
+	tflog.Debug(ctx, "Received bpkio API response", map[string]interface{}{
+		"status":   resp.StatusCode,
+		"duration": time.Since(start).String(),
+	})
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return string(data), newAPIError(resp, data)
+	}
+
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return string(data), fmt.Errorf("decoding response body: %w", err)
+		}
+	}
+	return string(data), nil
+}
+
+// paged appends the offset and limit query parameters of list endpoints.
+func paged(path string, offset, limit uint) string {
+	return fmt.Sprintf("%s?offset=%d&limit=%d", path, offset, limit)
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+)
+
+// GetAllTranscodingProfiles lists the transcoding profiles of the tenant.
+func (c *bpkioClient) GetAllTranscodingProfiles(ctx context.Context, offset, limit uint) ([]broadpeakio.TranscodingProfileOutput, error) {
+	var out []broadpeakio.TranscodingProfileOutput
+	err := c.getList(ctx, paged("transcoding-profiles", offset, limit), &out)
+	return out, err
+}
+
+// GetTranscodingProfile reads a transcoding profile.
+func (c *bpkioClient) GetTranscodingProfile(ctx context.Context, id uint) (broadpeakio.TranscodingProfileOutput, error) {
+	var out broadpeakio.TranscodingProfileOutput
+	err := c.get(ctx, fmt.Sprintf("transcoding-profiles/%d", id), &out)
+	return out, err
+}
+
+// transcodingProfileInput creates or replaces a transcoding profile. The SDK
+// has no model for it. Content is the JSON document of the profile, sent as a
+// string.
+type transcodingProfileInput struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// CreateTranscodingProfile creates a transcoding profile.
+func (c *bpkioClient) CreateTranscodingProfile(ctx context.Context, in transcodingProfileInput) (broadpeakio.TranscodingProfileOutput, error) {
+	var out broadpeakio.TranscodingProfileOutput
+	err := c.post(ctx, "transcoding-profiles", in, &out)
+	return out, err
+}
+
+// UpdateTranscodingProfile replaces the name and content of a transcoding
+// profile.
+func (c *bpkioClient) UpdateTranscodingProfile(ctx context.Context, id uint, in transcodingProfileInput) (broadpeakio.TranscodingProfileOutput, error) {
+	var out broadpeakio.TranscodingProfileOutput
+	err := c.put(ctx, fmt.Sprintf("transcoding-profiles/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteTranscodingProfile deletes a transcoding profile.
+func (c *bpkioClient) DeleteTranscodingProfile(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("transcoding-profiles/%d", id))
+}
+
+// categoryInput creates or replaces a category. It overrides the
+// subcategories of the SDK type, which are omitted when empty: a PUT without
+// them would keep the previous subcategories.
+type categoryInput struct {
+	broadpeakio.Category
+	Subcategories []broadpeakio.Subcategory `json:"subcategories"`
+}
+
+// GetAllCategories lists the categories of the tenant.
+func (c *bpkioClient) GetAllCategories(ctx context.Context, offset, limit uint) ([]broadpeakio.CategoryOutput, error) {
+	var out []broadpeakio.CategoryOutput
+	err := c.getList(ctx, paged("categories", offset, limit), &out)
+	return out, err
+}
+
+// CreateCategory creates a category.
+func (c *bpkioClient) CreateCategory(ctx context.Context, in categoryInput) (broadpeakio.CategoryOutput, error) {
+	var out broadpeakio.CategoryOutput
+	err := c.post(ctx, "categories", in, &out)
+	return out, err
+}
+
+// GetCategory reads a category.
+func (c *bpkioClient) GetCategory(ctx context.Context, id uint) (broadpeakio.CategoryOutput, error) {
+	var out broadpeakio.CategoryOutput
+	err := c.get(ctx, fmt.Sprintf("categories/%d", id), &out)
+	return out, err
+}
+
+// UpdateCategory replaces the name and subcategories of a category.
+func (c *bpkioClient) UpdateCategory(ctx context.Context, id uint, in categoryInput) (broadpeakio.CategoryOutput, error) {
+	var out broadpeakio.CategoryOutput
+	err := c.put(ctx, fmt.Sprintf("categories/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteCategory deletes a category.
+func (c *bpkioClient) DeleteCategory(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("categories/%d", id))
+}
+
+// tenantOutput describes the tenant an API key belongs to. The SDK has no
+// model for it.
+type tenantOutput struct {
+	Id             uint   `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	CommercialPlan string `json:"commercialPlan"`
+	State          string `json:"state"`
+	CreationDate   string `json:"creationDate"`
+	UpdateDate     string `json:"updateDate"`
+}
+
+// GetTenant reads the tenant the API key belongs to. It is the cheapest
+// authenticated call the API offers, so it also serves to validate the key.
+func (c *bpkioClient) GetTenant(ctx context.Context) (tenantOutput, error) {
+	var out tenantOutput
+	err := c.get(ctx, "tenants/me", &out)
+	return out, err
+}
+
+// GetCurrentUser reads the user the API key was issued to.
+func (c *bpkioClient) GetCurrentUser(ctx context.Context) (broadpeakio.UserOutput, error) {
+	var out broadpeakio.UserOutput
+	err := c.get(ctx, "users/me", &out)
+	return out, err
+}
@@ -0,0 +1,313 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+)
+
+// GetAllServices lists the services of every type.
+func (c *bpkioClient) GetAllServices(ctx context.Context, offset, limit uint) ([]broadpeakio.ServiceOutput, error) {
+	var out []broadpeakio.ServiceOutput
+	err := c.getList(ctx, paged("services", offset, limit), &out)
+	return out, err
+}
+
+// vodAdInsertion configures ad insertion in on-demand content. The SDK only
+// models the ad server reference, so the ad breaks are declared here.
+type vodAdInsertion struct {
+	AdServer *broadpeakio.Identifiable `json:"adServer,omitempty"`
+	PreRoll  *vodAdBreak               `json:"preRoll,omitempty"`
+	MidRoll  *vodMidRoll               `json:"midRoll,omitempty"`
+	PostRoll *vodAdBreak               `json:"postRoll,omitempty"`
+}
+
+// vodAdInsertionOutput is vodAdInsertion as returned by the API, with the ad
+// server reference resolved.
+type vodAdInsertionOutput struct {
+	AdServer broadpeakio.AdServer `json:"adServer"`
+	PreRoll  *vodAdBreak          `json:"preRoll,omitempty"`
+	MidRoll  *vodMidRoll          `json:"midRoll,omitempty"`
+	PostRoll *vodAdBreak          `json:"postRoll,omitempty"`
+}
+
+// vodAdBreak configures the pre-roll or post-roll break of a VOD service.
+type vodAdBreak struct {
+	MaxDuration uint `json:"maxDuration,omitempty"`
+}
+
+// vodMidRoll configures the mid-roll breaks of a VOD service, inserted at
+// the given positions (in seconds from the start of the content).
+type vodMidRoll struct {
+	Positions   []uint `json:"positions"`
+	MaxDuration uint   `json:"maxDuration,omitempty"`
+}
+
+// createAdInsertionInput, updateAdInsertionInput and adInsertionOutput replace
+// the SDK vodAdInsertion field with the complete one.
+type createAdInsertionInput struct {
+	broadpeakio.CreateAdInsertionInput
+	VodAdInsertion *vodAdInsertion `json:"vodAdInsertion,omitempty"`
+}
+
+type updateAdInsertionInput struct {
+	broadpeakio.UpdateAdInsertionInput
+	VodAdInsertion *vodAdInsertion `json:"vodAdInsertion,omitempty"`
+}
+
+type adInsertionOutput struct {
+	broadpeakio.AdInsertionOutput
+	VodAdInsertion vodAdInsertionOutput `json:"vodAdInsertion"`
+}
+
+// CreateAdInsertion creates an ad insertion service.
+func (c *bpkioClient) CreateAdInsertion(ctx context.Context, in createAdInsertionInput) (adInsertionOutput, error) {
+	var out adInsertionOutput
+	err := c.post(ctx, "services/ad-insertion", in, &out)
+	return out, err
+}
+
+// GetAdInsertion reads an ad insertion service.
+func (c *bpkioClient) GetAdInsertion(ctx context.Context, id uint) (adInsertionOutput, error) {
+	var out adInsertionOutput
+	err := c.get(ctx, fmt.Sprintf("services/ad-insertion/%d", id), &out)
+	return out, err
+}
+
+// UpdateAdInsertion updates an ad insertion service.
+func (c *bpkioClient) UpdateAdInsertion(ctx context.Context, id uint, in updateAdInsertionInput) (adInsertionOutput, error) {
+	var out adInsertionOutput
+	err := c.put(ctx, fmt.Sprintf("services/ad-insertion/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteAdInsertion deletes an ad insertion service.
+func (c *bpkioClient) DeleteAdInsertion(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("services/ad-insertion/%d", id))
+}
+
+// serviceSource is a source referenced by a service, with the format the SDK
+// does not model yet.
+type serviceSource struct {
+	broadpeakio.Source
+	Format string `json:"format"`
+}
+
+// contentReplacementInput adds the fields the SDK does not model yet to the
+// content replacement request.
+type contentReplacementInput struct {
+	broadpeakio.CreateContentReplacementInput
+	Tags               []string                  `json:"tags"`
+	State              string                    `json:"state,omitempty"`
+	TranscodingProfile *broadpeakio.Identifiable `json:"transcodingProfile,omitempty"`
+}
+
+// contentReplacementOutput adds the fields the SDK does not model yet to the
+// content replacement response.
+type contentReplacementOutput struct {
+	broadpeakio.ContentReplacementOutput
+	Type               string                               `json:"type"`
+	State              string                               `json:"state"`
+	Tags               []string                             `json:"tags"`
+	Source             serviceSource                        `json:"source"`
+	Replacement        serviceSource                        `json:"replacement"`
+	TranscodingProfile broadpeakio.TranscodingProfileOutput `json:"transcodingProfile"`
+}
+
+// CreateContentReplacement creates a content replacement service.
+func (c *bpkioClient) CreateContentReplacement(ctx context.Context, in contentReplacementInput) (contentReplacementOutput, error) {
+	var out contentReplacementOutput
+	err := c.post(ctx, "services/content-replacement", in, &out)
+	return out, err
+}
+
+// GetContentReplacement reads a content replacement service.
+func (c *bpkioClient) GetContentReplacement(ctx context.Context, id uint) (contentReplacementOutput, error) {
+	var out contentReplacementOutput
+	err := c.get(ctx, fmt.Sprintf("services/content-replacement/%d", id), &out)
+	return out, err
+}
+
+// UpdateContentReplacement updates a content replacement service.
+func (c *bpkioClient) UpdateContentReplacement(ctx context.Context, id uint, in contentReplacementInput) (contentReplacementOutput, error) {
+	var out contentReplacementOutput
+	err := c.put(ctx, fmt.Sprintf("services/content-replacement/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteContentReplacement deletes a content replacement service.
+func (c *bpkioClient) DeleteContentReplacement(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("services/content-replacement/%d", id))
+}
+
+// contentReplacementSlotInput adds the category, which the SDK only models on
+// updates, to the slot request.
+type contentReplacementSlotInput struct {
+	broadpeakio.CreateContentReplacementSlotInput
+	Category *broadpeakio.Identifiable `json:"category,omitempty"`
+}
+
+// contentReplacementSlotOutput adds the format of the replacement to the slot
+// response.
+type contentReplacementSlotOutput struct {
+	broadpeakio.ContentReplacementSlotOutput
+	Replacement serviceSource `json:"replacement"`
+}
+
+// contentReplacementSlotPath returns the path of a slot of a content
+// replacement service.
+func contentReplacementSlotPath(serviceID, id uint) string {
+	return fmt.Sprintf("services/content-replacement/%d/slots/%d", serviceID, id)
+}
+
+// CreateContentReplacementSlot schedules a replacement slot on a content
+// replacement service.
+func (c *bpkioClient) CreateContentReplacementSlot(ctx context.Context, serviceID uint, in contentReplacementSlotInput) (contentReplacementSlotOutput, error) {
+	var out contentReplacementSlotOutput
+	err := c.post(ctx, fmt.Sprintf("services/content-replacement/%d/slots", serviceID), in, &out)
+	return out, err
+}
+
+// GetContentReplacementSlot reads a slot of a content replacement service.
+func (c *bpkioClient) GetContentReplacementSlot(ctx context.Context, serviceID, id uint) (contentReplacementSlotOutput, error) {
+	var out contentReplacementSlotOutput
+	err := c.get(ctx, contentReplacementSlotPath(serviceID, id), &out)
+	return out, err
+}
+
+// UpdateContentReplacementSlot updates a slot of a content replacement service.
+func (c *bpkioClient) UpdateContentReplacementSlot(ctx context.Context, serviceID, id uint, in contentReplacementSlotInput) (contentReplacementSlotOutput, error) {
+	var out contentReplacementSlotOutput
+	err := c.put(ctx, contentReplacementSlotPath(serviceID, id), in, &out)
+	return out, err
+}
+
+// DeleteContentReplacementSlot deletes a slot of a content replacement service.
+func (c *bpkioClient) DeleteContentReplacementSlot(ctx context.Context, serviceID, id uint) (string, error) {
+	return c.delete(ctx, contentReplacementSlotPath(serviceID, id))
+}
+
+// adBreakInsertion configures the ad breaks of a virtual channel. It replaces
+// the SDK type, whose gap filler field is misspelled.
+type adBreakInsertion struct {
+	AdServer  *broadpeakio.Identifiable `json:"adServer,omitempty"`
+	GapFiller *broadpeakio.Identifiable `json:"gapFiller,omitempty"`
+}
+
+// virtualChannelInput adds the fields the SDK does not model yet to the
+// virtual channel request.
+type virtualChannelInput struct {
+	broadpeakio.CreateVirtualChannelInput
+	AdBreakInsertion *adBreakInsertion         `json:"adBreakInsertion,omitempty"`
+	DefaultSlate     *broadpeakio.Identifiable `json:"defaultSlate,omitempty"`
+	Tags             []string                  `json:"tags"`
+	State            string                    `json:"state,omitempty"`
+}
+
+// virtualChannelOutput adds the fields the SDK does not model yet to the
+// virtual channel response.
+type virtualChannelOutput struct {
+	broadpeakio.VirtualChannelOutput
+	Type         string        `json:"type"`
+	State        string        `json:"state"`
+	Tags         []string      `json:"tags"`
+	BaseLive     serviceSource `json:"baseLive"`
+	DefaultSlate serviceSource `json:"defaultSlate"`
+}
+
+// CreateVirtualChannel creates a virtual channel service.
+func (c *bpkioClient) CreateVirtualChannel(ctx context.Context, in virtualChannelInput) (virtualChannelOutput, error) {
+	var out virtualChannelOutput
+	err := c.post(ctx, "services/virtual-channel", in, &out)
+	return out, err
+}
+
+// GetVirtualChannel reads a virtual channel service.
+func (c *bpkioClient) GetVirtualChannel(ctx context.Context, id uint) (virtualChannelOutput, error) {
+	var out virtualChannelOutput
+	err := c.get(ctx, fmt.Sprintf("services/virtual-channel/%d", id), &out)
+	return out, err
+}
+
+// UpdateVirtualChannel updates a virtual channel service.
+func (c *bpkioClient) UpdateVirtualChannel(ctx context.Context, id uint, in virtualChannelInput) (virtualChannelOutput, error) {
+	var out virtualChannelOutput
+	err := c.put(ctx, fmt.Sprintf("services/virtual-channel/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteVirtualChannel deletes a virtual channel service.
+func (c *bpkioClient) DeleteVirtualChannel(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("services/virtual-channel/%d", id))
+}
+
+// virtualChannelSlotInput adds the replay flag, which the SDK does not model
+// yet, to the slot request.
+type virtualChannelSlotInput struct {
+	broadpeakio.CreateVirtualChannelSlotInput
+	Replay bool `json:"replay"`
+}
+
+// virtualChannelSlotOutput adds the fields the SDK does not model yet to the
+// slot response.
+type virtualChannelSlotOutput struct {
+	broadpeakio.VirtualChannelSlotOutput
+	Replacement serviceSource `json:"replacement"`
+	Replay      bool          `json:"replay"`
+}
+
+// virtualChannelSlotsPath returns the path of the slots of a virtual channel.
+func virtualChannelSlotsPath(serviceID uint) string {
+	return fmt.Sprintf("services/virtual-channel/%d/slots", serviceID)
+}
+
+// GetVirtualChannelSlots lists the slots of a virtual channel that overlap
+// the [from, to) window. Either bound may be empty to leave that side open.
+func (c *bpkioClient) GetVirtualChannelSlots(ctx context.Context, serviceID uint, from, to string, offset, limit uint) ([]virtualChannelSlotOutput, error) {
+	query := url.Values{}
+	if from != "" {
+		query.Set("from", from)
+	}
+	if to != "" {
+		query.Set("to", to)
+	}
+	path := paged(virtualChannelSlotsPath(serviceID), offset, limit)
+	if len(query) > 0 {
+		path += "&" + query.Encode()
+	}
+
+	var out []virtualChannelSlotOutput
+	err := c.get(ctx, path, &out)
+	return out, err
+}
+
+// CreateVirtualChannelSlot schedules a slot on a virtual channel.
+func (c *bpkioClient) CreateVirtualChannelSlot(ctx context.Context, serviceID uint, in virtualChannelSlotInput) (virtualChannelSlotOutput, error) {
+	var out virtualChannelSlotOutput
+	err := c.post(ctx, virtualChannelSlotsPath(serviceID), in, &out)
+	return out, err
+}
+
+// GetVirtualChannelSlot reads a slot of a virtual channel.
+func (c *bpkioClient) GetVirtualChannelSlot(ctx context.Context, serviceID, id uint) (virtualChannelSlotOutput, error) {
+	var out virtualChannelSlotOutput
+	err := c.get(ctx, fmt.Sprintf("%s/%d", virtualChannelSlotsPath(serviceID), id), &out)
+	return out, err
+}
+
+// UpdateVirtualChannelSlot updates a slot of a virtual channel.
+func (c *bpkioClient) UpdateVirtualChannelSlot(ctx context.Context, serviceID, id uint, in virtualChannelSlotInput) (virtualChannelSlotOutput, error) {
+	var out virtualChannelSlotOutput
+	err := c.put(ctx, fmt.Sprintf("%s/%d", virtualChannelSlotsPath(serviceID), id), in, &out)
+	return out, err
+}
+
+// DeleteVirtualChannelSlot deletes a slot of a virtual channel.
+func (c *bpkioClient) DeleteVirtualChannelSlot(ctx context.Context, serviceID, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("%s/%d", virtualChannelSlotsPath(serviceID), id))
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+)
+
+// sourceListOutput extends broadpeakio.SourceOutput with the listed fields
+// the SDK does not decode.
+type sourceListOutput struct {
+	broadpeakio.SourceOutput
+	Description string `json:"description"`
+	MultiPeriod bool   `json:"multiPeriod"`
+}
+
+// GetAllSources lists the sources of every type.
+func (c *bpkioClient) GetAllSources(ctx context.Context, offset, limit uint) ([]sourceListOutput, error) {
+	var out []sourceListOutput
+	err := c.getList(ctx, paged("sources", offset, limit), &out)
+	return out, err
+}
+
+// CreateLive creates a live source.
+func (c *bpkioClient) CreateLive(ctx context.Context, in broadpeakio.LiveInput) (broadpeakio.LiveOutput, error) {
+	var out broadpeakio.LiveOutput
+	err := c.post(ctx, "sources/live", in, &out)
+	return out, err
+}
+
+// GetLive reads a live source.
+func (c *bpkioClient) GetLive(ctx context.Context, id uint) (broadpeakio.LiveOutput, error) {
+	var out broadpeakio.LiveOutput
+	err := c.get(ctx, fmt.Sprintf("sources/live/%d", id), &out)
+	return out, err
+}
+
+// UpdateLive updates a live source.
+func (c *bpkioClient) UpdateLive(ctx context.Context, id uint, in broadpeakio.LiveInput) (broadpeakio.LiveOutput, error) {
+	var out broadpeakio.LiveOutput
+	err := c.put(ctx, fmt.Sprintf("sources/live/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteLive deletes a live source.
+func (c *bpkioClient) DeleteLive(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("sources/live/%d", id))
+}
+
+// CreateSlate creates a slate source.
+func (c *bpkioClient) CreateSlate(ctx context.Context, in broadpeakio.SlateInput) (broadpeakio.SlateOutput, error) {
+	var out broadpeakio.SlateOutput
+	err := c.post(ctx, "sources/slate", in, &out)
+	return out, err
+}
+
+// GetSlate reads a slate source.
+func (c *bpkioClient) GetSlate(ctx context.Context, id uint) (broadpeakio.SlateOutput, error) {
+	var out broadpeakio.SlateOutput
+	err := c.get(ctx, fmt.Sprintf("sources/slate/%d", id), &out)
+	return out, err
+}
+
+// UpdateSlate updates a slate source.
+func (c *bpkioClient) UpdateSlate(ctx context.Context, id uint, in broadpeakio.SlateInput) (broadpeakio.SlateOutput, error) {
+	var out broadpeakio.SlateOutput
+	err := c.put(ctx, fmt.Sprintf("sources/slate/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteSlate deletes a slate source.
+func (c *bpkioClient) DeleteSlate(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("sources/slate/%d", id))
+}
+
+// CreateAdServer creates an ad server source.
+func (c *bpkioClient) CreateAdServer(ctx context.Context, in broadpeakio.AdServerInput) (broadpeakio.AdServerOutput, error) {
+	var out broadpeakio.AdServerOutput
+	err := c.post(ctx, "sources/ad-server", in, &out)
+	return out, err
+}
+
+// GetAdServer reads an ad server source.
+func (c *bpkioClient) GetAdServer(ctx context.Context, id uint) (broadpeakio.AdServerOutput, error) {
+	var out broadpeakio.AdServerOutput
+	err := c.get(ctx, fmt.Sprintf("sources/ad-server/%d", id), &out)
+	return out, err
+}
+
+// UpdateAdServer updates an ad server source.
+func (c *bpkioClient) UpdateAdServer(ctx context.Context, id uint, in broadpeakio.AdServerInput) (broadpeakio.AdServerOutput, error) {
+	var out broadpeakio.AdServerOutput
+	err := c.put(ctx, fmt.Sprintf("sources/ad-server/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteAdServer deletes an ad server source.
+func (c *bpkioClient) DeleteAdServer(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("sources/ad-server/%d", id))
+}
+
+// CreateAsset creates a VOD asset source.
+func (c *bpkioClient) CreateAsset(ctx context.Context, in broadpeakio.AssetInput) (broadpeakio.AssetOutput, error) {
+	var out broadpeakio.AssetOutput
+	err := c.post(ctx, "sources/asset", in, &out)
+	return out, err
+}
+
+// GetAsset reads a VOD asset source.
+func (c *bpkioClient) GetAsset(ctx context.Context, id uint) (broadpeakio.AssetOutput, error) {
+	var out broadpeakio.AssetOutput
+	err := c.get(ctx, fmt.Sprintf("sources/asset/%d", id), &out)
+	return out, err
+}
+
+// UpdateAsset updates a VOD asset source.
+func (c *bpkioClient) UpdateAsset(ctx context.Context, id uint, in broadpeakio.AssetInput) (broadpeakio.AssetOutput, error) {
+	var out broadpeakio.AssetOutput
+	err := c.put(ctx, fmt.Sprintf("sources/asset/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteAsset deletes a VOD asset source.
+func (c *bpkioClient) DeleteAsset(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("sources/asset/%d", id))
+}
+
+// assetCatalogOutput extends broadpeakio.AssetCatalogOutput with the format
+// the API detects from the asset sample, which the SDK does not decode.
+type assetCatalogOutput struct {
+	broadpeakio.AssetCatalogOutput
+	Format string `json:"format"`
+}
+
+// CreateAssetCatalog creates a VOD asset catalog source.
+func (c *bpkioClient) CreateAssetCatalog(ctx context.Context, in broadpeakio.AssetCatalogInput) (assetCatalogOutput, error) {
+	var out assetCatalogOutput
+	err := c.post(ctx, "sources/asset-catalog", in, &out)
+	return out, err
+}
+
+// GetAssetCatalog reads a VOD asset catalog source.
+func (c *bpkioClient) GetAssetCatalog(ctx context.Context, id uint) (assetCatalogOutput, error) {
+	var out assetCatalogOutput
+	err := c.get(ctx, fmt.Sprintf("sources/asset-catalog/%d", id), &out)
+	return out, err
+}
+
+// UpdateAssetCatalog updates a VOD asset catalog source.
+func (c *bpkioClient) UpdateAssetCatalog(ctx context.Context, id uint, in broadpeakio.AssetCatalogInput) (assetCatalogOutput, error) {
+	var out assetCatalogOutput
+	err := c.put(ctx, fmt.Sprintf("sources/asset-catalog/%d", id), in, &out)
+	return out, err
+}
+
+// DeleteAssetCatalog deletes a VOD asset catalog source.
+func (c *bpkioClient) DeleteAssetCatalog(ctx context.Context, id uint) (string, error) {
+	return c.delete(ctx, fmt.Sprintf("sources/asset-catalog/%d", id))
+}
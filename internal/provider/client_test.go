@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		expect   string
+		wantErr  bool
+	}{
+		{name: "default", endpoint: "https://api.broadpeak.io", expect: "https://api.broadpeak.io/v1/"},
+		{name: "trailing slash", endpoint: "https://api.broadpeak.io/", expect: "https://api.broadpeak.io/v1/"},
+		{name: "versioned", endpoint: "https://api.broadpeak.io/v1", expect: "https://api.broadpeak.io/v1/"},
+		{name: "proxy path", endpoint: "http://proxy.internal:8080/bpkio", expect: "http://proxy.internal:8080/bpkio/v1/"},
+		{name: "missing scheme", endpoint: "api.broadpeak.io", wantErr: true},
+		{name: "unsupported scheme", endpoint: "ftp://api.broadpeak.io", wantErr: true},
+		{name: "missing host", endpoint: "https://", wantErr: true},
+		{name: "query string", endpoint: "https://api.broadpeak.io?debug=1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEndpoint(tt.endpoint)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expect, got)
+		})
+	}
+}
+
+func TestBpkioClient_UsesEndpoint(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{"id":7,"name":"slate","type":"slate"}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	slate, err := client.GetSlate(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, "/v1/sources/slate/7", gotPath)
+	require.Equal(t, "Bearer secret", gotAuth)
+	require.Equal(t, uint(7), slate.Id)
+	require.Equal(t, "slate", slate.Name)
+}
+
+func TestBpkioClient_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"statusCode":403,"message":"Forbidden resource","error":"Forbidden"}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	_, err = client.GetLive(context.Background(), 1)
+	require.ErrorContains(t, err, "403 Forbidden")
+	require.ErrorContains(t, err, "Forbidden resource")
+	require.False(t, isNotFound(err))
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"statusCode":404,"message":"Source not found","error":"Not Found"}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	_, err = client.GetSlate(context.Background(), 1)
+	require.True(t, isNotFound(err))
+	require.True(t, isNotFound(fmt.Errorf("reading slate: %w", err)))
+	require.False(t, isNotFound(nil))
+	require.False(t, isNotFound(errors.New("404 Not Found")))
+}
+
+func TestBpkioClient_Interrupted(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sources/slate/2" {
+			// Rate limited for longer than the operation may wait.
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.CreateLive(ctx, broadpeakio.LiveInput{Name: "live"})
+		require.Less(t, time.Since(start), 5*time.Second)
+
+		var ie *interruptedError
+		require.ErrorAs(t, err, &ie)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.ErrorContains(t, err, "POST sources/live abandoned: the operation timed out")
+		require.ErrorContains(t, err, "may still have been created")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		_, err := client.GetSlate(ctx, 1)
+		require.ErrorIs(t, err, context.Canceled)
+		require.EqualError(t, err, "GET sources/slate/1 abandoned: the operation was cancelled (context canceled)")
+	})
+
+	t.Run("retry backoff", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.GetSlate(ctx, 2)
+		require.Less(t, time.Since(start), 5*time.Second)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
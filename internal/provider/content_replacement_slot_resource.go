@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &contentReplacementSlotResource{}
+	_ resource.ResourceWithConfigure      = &contentReplacementSlotResource{}
+	_ resource.ResourceWithImportState    = &contentReplacementSlotResource{}
+	_ resource.ResourceWithValidateConfig = &contentReplacementSlotResource{}
+	_ resource.ResourceWithModifyPlan     = &contentReplacementSlotResource{}
+)
+
+// NewContentReplacementSlotResource is a helper function to simplify the provider implementation.
+func NewContentReplacementSlotResource() resource.Resource {
+	return &contentReplacementSlotResource{}
+}
+
+// contentReplacementSlotResource is the resource implementation.
+type contentReplacementSlotResource struct {
+	client *bpkioClient
+}
+
+// contentReplacementSlotAPIFields maps the request fields the API may reject
+// to the attributes they are read from.
+var contentReplacementSlotAPIFields = map[string]path.Path{
+	"name":           path.Root("name"),
+	"startTime":      path.Root("start_time"),
+	"endTime":        path.Root("end_time"),
+	"replacement":    path.Root("replacement"),
+	"replacement.id": path.Root("replacement").AtName("id"),
+	"category":       path.Root("category"),
+	"category.id":    path.Root("category").AtName("id"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *contentReplacementSlotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *contentReplacementSlotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_replacement_slot"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentReplacementSlotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a slot of a content replacement service, a window during which the live source is replaced, e.g. to black out a sports fixture.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the slot.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the content replacement service. Changing it forces a new slot to be created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the slot.",
+				Default:     stringdefault.StaticString(""),
+			},
+			"start_time": schema.StringAttribute{
+				Required: true,
+				Description: "Start of the replacement, as an RFC 3339 timestamp. " +
+					"It must be in the future when the slot is created.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Required: true,
+				Description: "End of the replacement, as an RFC 3339 timestamp. " +
+					"It must be after `start_time`, and in the future whenever the window changes.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Duration of the replacement, in seconds.",
+			},
+			"replacement": schema.SingleNestedAttribute{
+				Attributes:  serviceRefLiteAttributes("slate or asset"),
+				Required:    true,
+				Description: "Slate or asset played instead of the live source during the slot.",
+			},
+			"category": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Required:    true,
+						Description: "ID of the category.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the category.",
+					},
+				},
+				Optional:    true,
+				Description: "Category of the slot, used to target the replacement at some audiences.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig checks that the window of the slot is well formed.
+func (r *contentReplacementSlotResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config contentReplacementSlotResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOK := knownTime(config.StartTime)
+	end, endOK := knownTime(config.EndTime)
+	if startOK && endOK && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Invalid Replacement Window",
+			fmt.Sprintf("end_time (%s) must be after start_time (%s).", config.EndTime.ValueString(), config.StartTime.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan checks that the window of the slot is in the future. Only new or
+// moved windows are checked, so that past slots can still be refreshed and
+// destroyed, and an ongoing replacement can be extended. A slot replaced
+// because its service changed is checked as a new one.
+func (r *contentReplacementSlotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan contentReplacementSlotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state contentReplacementSlotResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	now := time.Now()
+	if req.State.Raw.IsNull() || !plan.ServiceID.Equal(state.ServiceID) {
+		if start, ok := knownTime(plan.StartTime); ok && !start.After(now) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("start_time"),
+				"Replacement Window In The Past",
+				fmt.Sprintf("start_time (%s) must be in the future.", plan.StartTime.ValueString()),
+			)
+		}
+		return
+	}
+
+	if plan.StartTime.Equal(state.StartTime) && plan.EndTime.Equal(state.EndTime) {
+		return
+	}
+	if end, ok := knownTime(plan.EndTime); ok && !end.After(now) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_time"),
+			"Replacement Window In The Past",
+			fmt.Sprintf("end_time (%s) must be in the future.", plan.EndTime.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *contentReplacementSlotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan contentReplacementSlotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(plan.ServiceID.ValueInt64())
+
+	// Create new slot
+	slot, err := r.client.CreateContentReplacementSlot(ctx, serviceID, expandContentReplacementSlot(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating Content-Replacement slot", fmt.Sprintf("Could not create slot on content replacement service ID %d", serviceID), err, contentReplacementSlotAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := flattenContentReplacementSlot(slot, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *contentReplacementSlotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state contentReplacementSlotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(state.ServiceID.ValueInt64())
+	slotID := uint(state.ID.ValueInt64())
+
+	slot, err := r.client.GetContentReplacementSlot(ctx, serviceID, slotID)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Content replacement slot no longer exists, removing it from state", map[string]interface{}{"service_id": serviceID, "id": slotID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Content Replacement Slot",
+			fmt.Sprintf("Could not read slot ID %d of content replacement service ID %d: %s", slotID, serviceID, err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state = flattenContentReplacementSlot(slot, state)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *contentReplacementSlotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan contentReplacementSlotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(plan.ServiceID.ValueInt64())
+	slotID := uint(plan.ID.ValueInt64())
+
+	// Update existing slot
+	slot, err := r.client.UpdateContentReplacementSlot(ctx, serviceID, slotID, expandContentReplacementSlot(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating Content-Replacement slot", fmt.Sprintf("Could not update slot ID %d of content replacement service ID %d", slotID, serviceID), err, contentReplacementSlotAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := flattenContentReplacementSlot(slot, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *contentReplacementSlotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state contentReplacementSlotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing slot
+	_, err := r.client.DeleteContentReplacementSlot(ctx, uint(state.ServiceID.ValueInt64()), uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Content Replacement Slot",
+			"Could not delete slot, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from a <service_id>/<slot_id> ID.
+func (r *contentReplacementSlotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, slotID, err := parseServiceChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing content replacement slot",
+			fmt.Sprintf("Invalid ID format: %s. Expected <service_id>/<slot_id>. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), slotID)...)
+}
+
+// expandContentReplacementSlot builds the API request for a slot from its
+// model.
+func expandContentReplacementSlot(m contentReplacementSlotResourceModel) contentReplacementSlotInput {
+	in := contentReplacementSlotInput{
+		CreateContentReplacementSlotInput: broadpeakio.CreateContentReplacementSlotInput{
+			Name:        m.Name.ValueString(),
+			StartTime:   m.StartTime.ValueString(),
+			EndTime:     m.EndTime.ValueString(),
+			Replacement: &broadpeakio.Identifiable{Id: uint(m.Replacement.ID.ValueInt64())},
+		},
+	}
+	if m.Category != nil {
+		in.Category = &broadpeakio.Identifiable{Id: uint(m.Category.ID.ValueInt64())}
+	}
+	return in
+}
+
+// flattenContentReplacementSlot maps a slot returned by the API to its model.
+// The API normalizes timestamps to UTC, so the times of prior are kept when
+// they denote the same instants. The service ID and timeouts come from prior.
+func flattenContentReplacementSlot(s contentReplacementSlotOutput, prior contentReplacementSlotResourceModel) contentReplacementSlotResourceModel {
+	m := contentReplacementSlotResourceModel{
+		ID:        types.Int64Value(int64(s.Id)),
+		ServiceID: prior.ServiceID,
+		Name:      types.StringValue(s.Name),
+		StartTime: types.StringValue(s.StartTime),
+		EndTime:   types.StringValue(s.EndTime),
+		Duration:  types.Int64Value(int64(s.Duration)),
+		Replacement: &sourceRefModel{
+			ID:   types.Int64Value(int64(s.Replacement.Id)),
+			Name: types.StringValue(s.Replacement.Name),
+			Type: types.StringValue(s.Replacement.Type),
+			URL:  types.StringValue(s.Replacement.Url),
+		},
+		Timeouts: prior.Timeouts,
+	}
+	if sameInstant(prior.StartTime.ValueString(), s.StartTime) {
+		m.StartTime = prior.StartTime
+	}
+	if sameInstant(prior.EndTime.ValueString(), s.EndTime) {
+		m.EndTime = prior.EndTime
+	}
+	if s.Category.Id != 0 {
+		m.Category = &categoryRefModel{
+			ID:   types.Int64Value(int64(s.Category.Id)),
+			Name: types.StringValue(s.Category.Name),
+		}
+	}
+	return m
+}
+
+// contentReplacementSlotResourceModel maps the slot resource schema data.
+type contentReplacementSlotResourceModel struct {
+	ID          types.Int64       `tfsdk:"id"`
+	ServiceID   types.Int64       `tfsdk:"service_id"`
+	Name        types.String      `tfsdk:"name"`
+	StartTime   types.String      `tfsdk:"start_time"`
+	EndTime     types.String      `tfsdk:"end_time"`
+	Duration    types.Int64       `tfsdk:"duration"`
+	Replacement *sourceRefModel   `tfsdk:"replacement"`
+	Category    *categoryRefModel `tfsdk:"category"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// categoryRefModel maps a category referenced by ID, with its name.
+type categoryRefModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
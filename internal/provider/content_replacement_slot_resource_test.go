@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccFutureTime returns a timestamp the given number of hours from now,
+// rounded to the hour and expressed in UTC+2 so that it differs from the
+// format returned by the API.
+func testAccFutureTime(hours int) string {
+	return time.Now().Truncate(time.Hour).Add(time.Duration(hours) * time.Hour).
+		In(time.FixedZone("", 2*60*60)).Format(time.RFC3339)
+}
+
+func TestAccContentReplacementSlot_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_content_replacement_slot.test"
+	start, end, later := testAccFutureTime(48), testAccFutureTime(50), testAccFutureTime(51)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", start, end, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "bpkio_service_content_replacement.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", start),
+					resource.TestCheckResourceAttr(resourceName, "end_time", end),
+					resource.TestCheckResourceAttr(resourceName, "duration", "7200"),
+					resource.TestCheckResourceAttrPair(resourceName, "replacement.id", "bpkio_source_slate.slate", "id"),
+					resource.TestCheckResourceAttr(resourceName, "replacement.type", "slate"),
+					resource.TestCheckNoResourceAttr(resourceName, "category"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSlotImportID(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_time", "end_time"},
+			},
+			{
+				// Extend the window and switch to an asset, in place.
+				Config: testAccContentReplacementSlotConfig(apiKey, "bpkio_source_asset.asset", start, later, `
+  name = "blackout"
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "blackout"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", later),
+					resource.TestCheckResourceAttr(resourceName, "duration", "10800"),
+					resource.TestCheckResourceAttr(resourceName, "replacement.type", "asset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContentReplacementSlot_Category(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_content_replacement_slot.test"
+	start, end := testAccFutureTime(48), testAccFutureTime(50)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", start, end, `
+  category = {
+    id = 42
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "category.id", "42"),
+					resource.TestCheckResourceAttr(resourceName, "category.name", "sports"),
+				),
+			},
+			{
+				Config: testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", start, end, ""),
+				Check:  resource.TestCheckNoResourceAttr(resourceName, "category"),
+			},
+		},
+	})
+}
+
+func TestAccContentReplacementSlot_InvalidWindow(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", testAccFutureTime(50), testAccFutureTime(48), ""),
+				ExpectError: regexp.MustCompile(`Invalid Replacement Window`),
+			},
+			{
+				Config:      testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", "2020-05-01T20:00:00Z", "2020-05-01T22:00:00Z", ""),
+				ExpectError: regexp.MustCompile(`Replacement Window In The Past`),
+			},
+			{
+				Config:      testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", "tomorrow", testAccFutureTime(48), ""),
+				ExpectError: regexp.MustCompile(`RFC 3339`),
+			},
+		},
+	})
+}
+
+func TestAccContentReplacementSlot_InvalidReplacement(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// A live source cannot replace the live source.
+				Config:      testAccContentReplacementSlotConfig(apiKey, "bpkio_source_live.live", testAccFutureTime(48), testAccFutureTime(50), ""),
+				ExpectError: regexp.MustCompile(`(?i)403|forbidden|not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccContentReplacementSlot_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_content_replacement_slot.test"
+	config := testAccContentReplacementSlotConfig(apiKey, "bpkio_source_slate.slate", testAccFutureTime(48), testAccFutureTime(50), "")
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteSlot(id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+func TestAccContentReplacementSlot_ReplacedAfterStart(t *testing.T) {
+	apiKey := testAccAPIKey()
+	start := time.Now().Add(5 * time.Second).UTC().Truncate(time.Second)
+	end := testAccFutureTime(50)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContentReplacementSlotMovedConfig(apiKey, "test", start.Format(time.RFC3339), end),
+			},
+			{
+				// Moving the started slot to another service creates a new
+				// slot, which must not start in the past.
+				PreConfig:   func() { time.Sleep(time.Until(start.Add(time.Second))) },
+				Config:      testAccContentReplacementSlotMovedConfig(apiKey, "other", start.Format(time.RFC3339), end),
+				ExpectError: regexp.MustCompile(`Replacement Window In The Past`),
+			},
+			{
+				// The started slot itself is left alone.
+				Config:   testAccContentReplacementSlotMovedConfig(apiKey, "test", start.Format(time.RFC3339), end),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccContentReplacementSlotMovedConfig schedules a slate replacement on
+// one of two content replacement services, "test" or "other".
+func testAccContentReplacementSlotMovedConfig(apiKey, service, startTime, endTime string) string {
+	return testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement-slots", "bpkio_source_slate.slate", "") + fmt.Sprintf(`
+resource "bpkio_service_content_replacement" "other" {
+  name = "tf-acc-content-replacement-slots-other"
+
+  source = {
+    id = bpkio_source_live.live.id
+  }
+
+  replacement = {
+    id = bpkio_source_slate.slate.id
+  }
+}
+
+resource "bpkio_content_replacement_slot" "test" {
+  service_id = bpkio_service_content_replacement.%s.id
+  start_time = "%s"
+  end_time   = "%s"
+
+  replacement = {
+    id = bpkio_source_slate.slate.id
+  }
+}
+`, service, startTime, endTime)
+}
+
+// testAccContentReplacementSlotConfig schedules a replacement by the given
+// source on a content replacement service; extra is added to the body of the
+// slot.
+func testAccContentReplacementSlotConfig(apiKey, replacement, startTime, endTime, extra string) string {
+	return testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement-slots", "bpkio_source_slate.slate", "") + fmt.Sprintf(`
+resource "bpkio_content_replacement_slot" "test" {
+  service_id = bpkio_service_content_replacement.test.id
+  start_time = "%s"
+  end_time   = "%s"
+
+  replacement = {
+    id = %s.id
+  }
+%s
+}
+`, startTime, endTime, replacement, extra)
+}
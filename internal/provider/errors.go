@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiError is returned for responses with a non-2xx status. It carries the
+// decoded error payload, so callers can branch on the status code and report
+// field violations against the attribute they concern.
+type apiError struct {
+	StatusCode int
+	Status     string
+	// Code is the machine readable error, e.g. "Bad Request".
+	Code string
+	// Messages are the human readable messages of the payload.
+	Messages   []string
+	Violations []fieldViolation
+	Body       string
+}
+
+// fieldViolation is an error the API attributes to a single request field.
+// Field is the JSON path of the field in the request body, e.g.
+// "liveAdReplacement.adServer.id".
+type fieldViolation struct {
+	Field   string
+	Message string
+}
+
+// apiErrorPayload is the error body returned by the Broadpeak API. The
+// message is either a string or, for request validation failures, a list of
+// messages that each start with the offending field.
+type apiErrorPayload struct {
+	StatusCode int             `json:"statusCode"`
+	Code       string          `json:"code"`
+	Error      string          `json:"error"`
+	Message    json.RawMessage `json:"message"`
+	Errors     []struct {
+		Field       string            `json:"field"`
+		Property    string            `json:"property"`
+		Message     string            `json:"message"`
+		Constraints map[string]string `json:"constraints"`
+	} `json:"errors"`
+}
+
+// violationPattern matches validation messages such as
+// "url must be a URL address" or "liveAdReplacement.adServer.id must be a
+// number", where the message starts with the camelCase path of the field.
+var violationPattern = regexp.MustCompile(`^([a-z][A-Za-z0-9]*(?:\.[A-Za-z0-9]+)*) ([a-z].*)$`)
+
+// newAPIError decodes the error payload of resp. Bodies that are not JSON are
+// kept verbatim.
+func newAPIError(resp *http.Response, body []byte) *apiError {
+	e := &apiError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       string(body),
+	}
+
+	var payload apiErrorPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return e
+	}
+
+	e.Code = payload.Code
+	if e.Code == "" {
+		e.Code = payload.Error
+	}
+
+	var single string
+	if err := json.Unmarshal(payload.Message, &single); err == nil && single != "" {
+		e.Messages = []string{single}
+	} else {
+		_ = json.Unmarshal(payload.Message, &e.Messages)
+	}
+	for _, msg := range e.Messages {
+		if m := violationPattern.FindStringSubmatch(msg); m != nil {
+			e.Violations = append(e.Violations, fieldViolation{Field: m[1], Message: msg})
+		}
+	}
+
+	for _, v := range payload.Errors {
+		field := v.Field
+		if field == "" {
+			field = v.Property
+		}
+		messages := []string{v.Message}
+		if v.Message == "" {
+			messages = messages[:0]
+			for _, rule := range slices.Sorted(maps.Keys(v.Constraints)) {
+				messages = append(messages, v.Constraints[rule])
+			}
+		}
+		for _, msg := range messages {
+			e.Messages = append(e.Messages, msg)
+			if field != "" {
+				e.Violations = append(e.Violations, fieldViolation{Field: field, Message: msg})
+			}
+		}
+	}
+
+	return e
+}
+
+func (e *apiError) Error() string {
+	if len(e.Messages) == 0 {
+		if e.Body == "" {
+			return e.Status
+		}
+		return fmt.Sprintf("%s: %s", e.Status, e.Body)
+	}
+	return fmt.Sprintf("%s: %s", e.Status, strings.Join(e.Messages, "; "))
+}
+
+// interruptedError is returned for requests abandoned because the context of
+// the operation was cancelled or its deadline passed, e.g. on Ctrl-C or when
+// a timeouts block expires. It unwraps to the context error.
+type interruptedError struct {
+	Method string
+	Path   string
+	Err    error
+}
+
+func (e *interruptedError) Error() string {
+	reason := "the operation was cancelled"
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		reason = "the operation timed out"
+	}
+	msg := fmt.Sprintf("%s %s abandoned: %s (%s)", e.Method, e.Path, reason, e.Err)
+
+	// The request may have reached Broadpeak before it was abandoned.
+	switch e.Method {
+	case http.MethodPost:
+		msg += "; the object may still have been created, import it rather than creating it again"
+	case http.MethodPut, http.MethodDelete:
+		msg += "; the change may still have been applied, the next refresh reads it back"
+	}
+	return msg
+}
+
+func (e *interruptedError) Unwrap() error {
+	return e.Err
+}
+
+// interrupted returns an interruptedError for a request to path that failed
+// with err once ctx was done, and err unchanged otherwise.
+func interrupted(ctx context.Context, method, path string, err error) error {
+	var ie *interruptedError
+	if ctx.Err() == nil || errors.As(err, &ie) {
+		return err
+	}
+	return &interruptedError{Method: method, Path: path, Err: ctx.Err()}
+}
+
+// isNotFound reports whether err is an API response with status 404.
+func isNotFound(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// addAPIError reports err as an error diagnostic. Field violations whose
+// request field is a key of fields are reported against the matching schema
+// attribute, so Terraform points at the offending line of the configuration;
+// anything else is reported as a single resource-level error.
+func addAPIError(diags *diag.Diagnostics, summary, detail string, err error, fields map[string]path.Path) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) || len(apiErr.Violations) == 0 {
+		diags.AddError(summary, fmt.Sprintf("%s: %s", detail, err))
+		return
+	}
+
+	var unmapped []string
+	for _, v := range apiErr.Violations {
+		p, ok := fields[v.Field]
+		if !ok {
+			unmapped = append(unmapped, v.Message)
+			continue
+		}
+		diags.AddAttributeError(p, summary, fmt.Sprintf("%s: %s (%s)", detail, v.Message, apiErr.Status))
+	}
+
+	// Messages that are not field violations, such as a 403 reason, must
+	// not be lost when some violations were mapped.
+	for _, msg := range apiErr.Messages {
+		if !apiErr.isViolation(msg) {
+			unmapped = append(unmapped, msg)
+		}
+	}
+	if len(unmapped) > 0 {
+		diags.AddError(summary, fmt.Sprintf("%s: %s: %s", detail, apiErr.Status, strings.Join(unmapped, "; ")))
+	}
+}
+
+// isViolation reports whether msg belongs to one of the field violations.
+func (e *apiError) isViolation(msg string) bool {
+	for _, v := range e.Violations {
+		if v.Message == msg {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/require"
+)
+
+func testAPIError(status int, body string) *apiError {
+	resp := &http.Response{StatusCode: status, Status: http.StatusText(status)}
+	return newAPIError(resp, []byte(body))
+}
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		code       string
+		messages   []string
+		violations []fieldViolation
+	}{
+		{
+			name:     "message string",
+			status:   http.StatusForbidden,
+			body:     `{"statusCode":403,"message":"Cannot create a source with the same name and URL","error":"Forbidden"}`,
+			code:     "Forbidden",
+			messages: []string{"Cannot create a source with the same name and URL"},
+		},
+		{
+			name:     "validation messages",
+			status:   http.StatusBadRequest,
+			body:     `{"statusCode":400,"message":["url must be a URL address","liveAdReplacement.adServer.id must be a number"],"error":"Bad Request"}`,
+			code:     "Bad Request",
+			messages: []string{"url must be a URL address", "liveAdReplacement.adServer.id must be a number"},
+			violations: []fieldViolation{
+				{Field: "url", Message: "url must be a URL address"},
+				{Field: "liveAdReplacement.adServer.id", Message: "liveAdReplacement.adServer.id must be a number"},
+			},
+		},
+		{
+			name:     "structured errors",
+			status:   http.StatusBadRequest,
+			body:     `{"statusCode":400,"code":"VALIDATION_ERROR","errors":[{"property":"name","constraints":{"isNotEmpty":"name should not be empty"}},{"field":"source.id","message":"unknown source"}]}`,
+			code:     "VALIDATION_ERROR",
+			messages: []string{"name should not be empty", "unknown source"},
+			violations: []fieldViolation{
+				{Field: "name", Message: "name should not be empty"},
+				{Field: "source.id", Message: "unknown source"},
+			},
+		},
+		{
+			name:   "not json",
+			status: http.StatusBadGateway,
+			body:   `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := testAPIError(tt.status, tt.body)
+			require.Equal(t, tt.status, err.StatusCode)
+			require.Equal(t, tt.code, err.Code)
+			require.Equal(t, tt.messages, err.Messages)
+			require.Equal(t, tt.violations, err.Violations)
+			if len(tt.messages) == 0 {
+				require.Contains(t, err.Error(), tt.body)
+			}
+		})
+	}
+}
+
+func TestAPIErrorString(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{name: "messages", status: http.StatusForbidden, body: `{"statusCode":403,"message":"Cannot create a source with the same name and URL","error":"Forbidden"}`, want: "Forbidden: Cannot create a source with the same name and URL"},
+		{name: "raw body", status: http.StatusBadGateway, body: `<html>Bad Gateway</html>`, want: "Bad Gateway: <html>Bad Gateway</html>"},
+		{name: "empty body", status: http.StatusNotFound, want: "Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualError(t, testAPIError(tt.status, tt.body), tt.want)
+		})
+	}
+}
+
+func TestAddAPIError(t *testing.T) {
+	fields := map[string]path.Path{
+		"url":                           path.Root("url"),
+		"liveAdReplacement.adServer.id": path.Root("live_ad_replacement").AtName("ad_server").AtName("id"),
+	}
+
+	t.Run("mapped violations", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := testAPIError(http.StatusBadRequest, `{"statusCode":400,"message":["url must be a URL address","liveAdReplacement.adServer.id must be a number"],"error":"Bad Request"}`)
+		addAPIError(&diags, "Error creating", "Could not create", err, fields)
+
+		require.Len(t, diags, 2)
+		require.Equal(t, path.Root("url"), diags[0].(diag.DiagnosticWithPath).Path())
+		require.Equal(t, path.Root("live_ad_replacement").AtName("ad_server").AtName("id"), diags[1].(diag.DiagnosticWithPath).Path())
+		require.Contains(t, diags[0].Detail(), "url must be a URL address")
+	})
+
+	t.Run("unmapped violations", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := testAPIError(http.StatusBadRequest, `{"statusCode":400,"message":["url must be a URL address","tags must be an array"],"error":"Bad Request"}`)
+		addAPIError(&diags, "Error creating", "Could not create", err, fields)
+
+		require.Len(t, diags, 2)
+		_, ok := diags[1].(diag.DiagnosticWithPath)
+		require.False(t, ok)
+		require.Contains(t, diags[1].Detail(), "tags must be an array")
+	})
+
+	t.Run("no violations", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := testAPIError(http.StatusForbidden, `{"statusCode":403,"message":"You are not allowed to use source 12","error":"Forbidden"}`)
+		addAPIError(&diags, "Error creating", "Could not create", err, fields)
+
+		require.Len(t, diags, 1)
+		require.Equal(t, "Could not create: Forbidden: You are not allowed to use source 12", diags[0].Detail())
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addAPIError(&diags, "Error creating", "Could not create", errors.New("connection refused"), fields)
+
+		require.Len(t, diags, 1)
+		require.Equal(t, "Could not create: connection refused", diags[0].Detail())
+	})
+}
+
+func TestInterrupted(t *testing.T) {
+	live := context.Background()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := errors.New("connection reset")
+	require.Same(t, err, interrupted(live, http.MethodGet, "sources", err), "errors of live operations are kept")
+
+	err = interrupted(cancelled, http.MethodPut, "sources/slate/1", err)
+	require.ErrorIs(t, err, context.Canceled)
+	require.EqualError(t, err, "PUT sources/slate/1 abandoned: the operation was cancelled (context canceled); "+
+		"the change may still have been applied, the next refresh reads it back")
+	require.Same(t, err, interrupted(cancelled, http.MethodGet, "sources", err), "interruptions are not wrapped twice")
+
+	err = interrupted(cancelled, http.MethodDelete, "categories/1", errors.New("context canceled"))
+	require.ErrorContains(t, err, "DELETE categories/1 abandoned")
+}
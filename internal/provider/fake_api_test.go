@@ -0,0 +1,1572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fakeAPIKey is the bearer token accepted by the fake Broadpeak API.
+const fakeAPIKey = "tf-acc-fake-api-key"
+
+// fakeTenantID is the id of the tenant the fake API key belongs to.
+const fakeTenantID = 1
+
+// fakeObject is a JSON object as stored and served by the fake API.
+type fakeObject map[string]any
+
+// fakeBroadpeakAPI is an in-memory stand-in for the Broadpeak REST API,
+// served over httptest. It keeps server-side state between requests so that
+// acceptance tests can exercise CRUD, import and drift without credentials.
+type fakeBroadpeakAPI struct {
+	*httptest.Server
+
+	apiKey string
+
+	mu         sync.Mutex
+	nextID     uint
+	sources    map[uint]fakeObject
+	services   map[uint]fakeObject
+	slots      map[uint]fakeObject
+	profiles   map[uint]fakeObject
+	categories map[uint]fakeObject
+
+	// throttle is the number of upcoming requests answered with 429.
+	throttle int
+	// stalls is the number of upcoming writes left hanging for stallFor, or
+	// until the client gives up.
+	stalls   int
+	stallFor time.Duration
+	// pageLimit caps the number of items of a listing page, when set.
+	pageLimit int
+}
+
+// fakeSourceFields lists, per source type, the writable fields the API keeps.
+var fakeSourceFields = map[string][]string{
+	"live":          {"name", "url", "description", "backupIp", "multiPeriod", "origin"},
+	"slate":         {"name", "url", "description"},
+	"asset":         {"name", "url", "description", "backupIp"},
+	"asset-catalog": {"name", "url", "description", "backupIp", "assetSample"},
+	"ad-server":     {"name", "url", "description", "queries", "queryParameters", "template"},
+}
+
+// newFakeBroadpeakAPI starts a fake API that accepts the given API key. It is
+// seeded with the transcoding profile and the category used by the
+// acceptance tests.
+func newFakeBroadpeakAPI(apiKey string) *fakeBroadpeakAPI {
+	f := &fakeBroadpeakAPI{
+		apiKey:   apiKey,
+		nextID:   1000,
+		sources:  map[uint]fakeObject{},
+		services: map[uint]fakeObject{},
+		slots:    map[uint]fakeObject{},
+		categories: map[uint]fakeObject{
+			42: {
+				"id":            42,
+				"name":          "sports",
+				"subcategories": []any{fakeObject{"key": "league", "value": "premier-league"}},
+			},
+		},
+		profiles: map[uint]fakeObject{
+			5763: {
+				"id":         5763,
+				"name":       "bpkio-h264-ladder",
+				"internalId": "bpk-tp-5763",
+				"content":    `{"packaging":{"--hls-client-manifest-version":"4"},"servicetype":"offline_transcoding","transcoding":{"jobs":[{"level":"0","type":"video","codecv":"h264","bitratev":"1500k","scaling":"1280:720","framerate":"25"},{"level":"1","type":"audio","codeca":"aac","bitratea":"128k"}]}}`,
+			},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/sources", f.listSources)
+	mux.HandleFunc("POST /v1/sources/{kind}", f.createSource)
+	mux.HandleFunc("GET /v1/sources/{kind}/{id}", f.getSource)
+	mux.HandleFunc("PUT /v1/sources/{kind}/{id}", f.updateSource)
+	mux.HandleFunc("DELETE /v1/sources/{kind}/{id}", f.deleteSource)
+	mux.HandleFunc("GET /v1/services", f.listServices)
+	mux.HandleFunc("POST /v1/services/{kind}", f.createService)
+	mux.HandleFunc("GET /v1/services/{kind}/{id}", f.getService)
+	mux.HandleFunc("PUT /v1/services/{kind}/{id}", f.updateService)
+	mux.HandleFunc("DELETE /v1/services/{kind}/{id}", f.deleteService)
+	mux.HandleFunc("GET /v1/services/{kind}/{service}/slots", f.listSlots)
+	mux.HandleFunc("POST /v1/services/{kind}/{service}/slots", f.createSlot)
+	mux.HandleFunc("GET /v1/services/{kind}/{service}/slots/{id}", f.getSlot)
+	mux.HandleFunc("PUT /v1/services/{kind}/{service}/slots/{id}", f.updateSlot)
+	mux.HandleFunc("DELETE /v1/services/{kind}/{service}/slots/{id}", f.deleteSlot)
+	mux.HandleFunc("GET /v1/transcoding-profiles", f.listProfiles)
+	mux.HandleFunc("POST /v1/transcoding-profiles", f.createProfile)
+	mux.HandleFunc("GET /v1/transcoding-profiles/{id}", f.getProfile)
+	mux.HandleFunc("PUT /v1/transcoding-profiles/{id}", f.updateProfile)
+	mux.HandleFunc("DELETE /v1/transcoding-profiles/{id}", f.deleteProfile)
+	mux.HandleFunc("GET /v1/categories", f.listCategories)
+	mux.HandleFunc("POST /v1/categories", f.createCategory)
+	mux.HandleFunc("GET /v1/categories/{id}", f.getCategory)
+	mux.HandleFunc("PUT /v1/categories/{id}", f.updateCategory)
+	mux.HandleFunc("DELETE /v1/categories/{id}", f.deleteCategory)
+	mux.HandleFunc("GET /v1/tenants/me", f.getTenant)
+	mux.HandleFunc("GET /v1/users/me", f.getCurrentUser)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Cannot %s %s", r.Method, r.URL.Path))
+	})
+
+	f.Server = httptest.NewServer(f.authenticate(f.rateLimit(f.stall(mux))))
+	return f
+}
+
+// authenticate rejects requests that do not carry the expected bearer token.
+func (f *fakeBroadpeakAPI) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+f.apiKey {
+			writeFakeError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rateLimit answers with 429 while throttled requests remain.
+func (f *fakeBroadpeakAPI) rateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		throttled := f.throttle > 0
+		if throttled {
+			f.throttle--
+		}
+		f.mu.Unlock()
+
+		if throttled {
+			writeFakeError(w, http.StatusTooManyRequests, "ThrottlerException: Too Many Requests")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// stall holds back writes while stalled writes remain. A write whose client
+// gives up in the meantime is dropped, as a proxy would.
+func (f *fakeBroadpeakAPI) stall(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		f.mu.Lock()
+		stalled, d := f.stalls > 0, f.stallFor
+		if stalled {
+			f.stalls--
+		}
+		f.mu.Unlock()
+
+		if stalled {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(d):
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Stall makes the next n writes hang for d before they are handled.
+func (f *fakeBroadpeakAPI) Stall(n int, d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stalls, f.stallFor = n, d
+}
+
+// LimitPages caps listing pages at n items, whatever limit is requested; zero
+// lifts the cap.
+func (f *fakeBroadpeakAPI) LimitPages(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pageLimit = n
+}
+
+// Throttle makes the next n requests fail with 429 Too Many Requests.
+func (f *fakeBroadpeakAPI) Throttle(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.throttle = n
+}
+
+// PendingThrottle returns how many requests are still to be throttled.
+func (f *fakeBroadpeakAPI) PendingThrottle() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.throttle
+}
+
+// SetSourceField changes a source behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) SetSourceField(id uint, field string, value any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if src, ok := f.sources[id]; ok {
+		src[field] = value
+	}
+}
+
+// DeleteSource removes a source behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) DeleteSource(id uint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.sources, id)
+}
+
+// SetServiceField changes a service behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) SetServiceField(id uint, field string, value any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if svc, ok := f.services[id]; ok {
+		svc[field] = value
+	}
+}
+
+// DeleteService removes a service behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) DeleteService(id uint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeService(id)
+}
+
+// SetSlotField changes a slot behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) SetSlotField(id uint, field string, value any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if slot, ok := f.slots[id]; ok {
+		slot[field] = value
+	}
+}
+
+// DeleteSlot removes a slot behind Terraform's back, to simulate drift.
+func (f *fakeBroadpeakAPI) DeleteSlot(id uint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.slots, id)
+}
+
+// ---------------------------------------------------------------------------
+// Sources
+// ---------------------------------------------------------------------------
+
+func (f *fakeBroadpeakAPI) listSources(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	items := make([]fakeObject, 0, len(f.sources))
+	for _, id := range sortedFakeIDs(f.sources) {
+		items = append(items, copyFakeObject(f.sources[id]))
+	}
+	writeFakeJSON(w, http.StatusOK, f.paginate(r, items))
+}
+
+func (f *fakeBroadpeakAPI) createSource(w http.ResponseWriter, r *http.Request) {
+	kind := r.PathValue("kind")
+	fields, ok := fakeSourceFields[kind]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Cannot POST %s", r.URL.Path))
+		return
+	}
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	src := fakeObject{"type": kind}
+	for _, field := range fields {
+		if v, ok := body[field]; ok {
+			src[field] = v
+		}
+	}
+	if msg, status := f.validateSource(kind, src, 0); msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	f.nextID++
+	src["id"] = f.nextID
+	completeFakeSource(src)
+	f.sources[f.nextID] = src
+	writeFakeJSON(w, http.StatusCreated, copyFakeObject(src))
+}
+
+func (f *fakeBroadpeakAPI) getSource(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	src, ok := f.lookupSource(w, r)
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(src))
+}
+
+func (f *fakeBroadpeakAPI) updateSource(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	src, ok := f.lookupSource(w, r)
+	if !ok {
+		return
+	}
+	kind := r.PathValue("kind")
+	id := fakeID(src["id"])
+
+	// PUT replaces every writable field; omitted ones fall back to defaults.
+	updated := fakeObject{"id": src["id"], "type": kind}
+	for _, field := range fakeSourceFields[kind] {
+		if v, ok := body[field]; ok {
+			updated[field] = v
+		}
+	}
+	if msg, status := f.validateSource(kind, updated, id); msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+	if updated["url"] != src["url"] && f.sourceInUse(id) {
+		writeFakeError(w, http.StatusForbidden, "Cannot update the URL of a source that is used by a service")
+		return
+	}
+
+	completeFakeSource(updated)
+	f.sources[id] = updated
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(updated))
+}
+
+func (f *fakeBroadpeakAPI) deleteSource(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	src, ok := f.lookupSource(w, r)
+	if !ok {
+		return
+	}
+	id := fakeID(src["id"])
+	if f.sourceInUse(id) {
+		writeFakeError(w, http.StatusForbidden, "Cannot delete a source that is used by a service")
+		return
+	}
+	delete(f.sources, id)
+	writeFakeJSON(w, http.StatusOK, fakeObject{"message": "Source deleted"})
+}
+
+// lookupSource resolves the {kind}/{id} path of a request to a stored source.
+func (f *fakeBroadpeakAPI) lookupSource(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	kind := r.PathValue("kind")
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	src, ok := f.sources[uint(id)]
+	if !ok || src["type"] != kind {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Source %d not found", id))
+		return nil, false
+	}
+	return src, true
+}
+
+// validateSource mimics the checks the API performs before storing a source.
+func (f *fakeBroadpeakAPI) validateSource(kind string, src fakeObject, self uint) (string, int) {
+	name, _ := src["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return msg, http.StatusBadRequest
+	}
+
+	rawURL, _ := src["url"].(string)
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "url must be a URL address", http.StatusBadRequest
+	}
+	if sample, _ := src["assetSample"].(string); kind == "asset-catalog" && sample == "" {
+		return "assetSample should not be empty", http.StatusBadRequest
+	}
+	// Ad servers are only called at playback time; every other source is
+	// probed on creation, so unreachable hosts are refused.
+	if kind != "ad-server" && strings.Contains(u.Host, "does-not-exist") {
+		return fmt.Sprintf("Source %s is unreachable", rawURL), http.StatusBadRequest
+	}
+
+	for id, other := range f.sources {
+		if id != self && other["type"] == kind && other["name"] == name && other["url"] == rawURL {
+			return "Cannot create a source with the same name and URL", http.StatusForbidden
+		}
+	}
+	return "", 0
+}
+
+// sourceInUse reports whether a service or a slot references the given
+// source.
+func (f *fakeBroadpeakAPI) sourceInUse(id uint) bool {
+	for _, svc := range f.services {
+		for _, ref := range fakeServiceRefs(svc) {
+			if ref == id {
+				return true
+			}
+		}
+	}
+	for _, slot := range f.slots {
+		if fakeRefID(slot, "replacement") == id {
+			return true
+		}
+	}
+	return false
+}
+
+// completeFakeSource fills the computed and defaulted fields of a source.
+func completeFakeSource(src fakeObject) {
+	if _, ok := src["description"]; !ok {
+		src["description"] = ""
+	}
+
+	rawURL, _ := src["url"].(string)
+	switch src["type"] {
+	case "live":
+		if _, ok := src["multiPeriod"]; !ok {
+			src["multiPeriod"] = false
+		}
+		if _, ok := src["origin"]; !ok {
+			src["origin"] = fakeObject{"customHeaders": []any{}}
+		}
+		src["format"] = fakeFormat(rawURL)
+	case "slate", "asset":
+		src["format"] = fakeFormat(rawURL)
+	case "asset-catalog":
+		sample, _ := src["assetSample"].(string)
+		src["format"] = fakeFormat(sample)
+	case "ad-server":
+		if _, ok := src["queryParameters"]; !ok {
+			src["queryParameters"] = []any{}
+		}
+		if _, ok := src["template"]; !ok {
+			src["template"] = "custom"
+		}
+	}
+}
+
+// fakeFormat derives the media format the API would detect from a URL.
+func fakeFormat(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(path.Ext(u.Path)) {
+	case ".m3u8":
+		return "hls"
+	case ".mpd":
+		return "dash"
+	case ".jpg", ".jpeg":
+		return "jpeg"
+	case ".png":
+		return "png"
+	case ".mp4":
+		return "mp4"
+	}
+	return "hls"
+}
+
+// SetCategoryField changes a category behind Terraform's back, to simulate
+// drift.
+func (f *fakeBroadpeakAPI) SetCategoryField(id uint, field string, value any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if category, ok := f.categories[id]; ok {
+		category[field] = value
+	}
+}
+
+// DeleteCategory removes a category behind Terraform's back, to simulate
+// drift.
+func (f *fakeBroadpeakAPI) DeleteCategory(id uint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.categories, id)
+}
+
+// SetProfileField changes a transcoding profile behind Terraform's back, to
+// simulate drift.
+func (f *fakeBroadpeakAPI) SetProfileField(id uint, field string, value any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if profile, ok := f.profiles[id]; ok {
+		profile[field] = value
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Services
+// ---------------------------------------------------------------------------
+
+func (f *fakeBroadpeakAPI) listServices(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	items := make([]fakeObject, 0, len(f.services))
+	for _, id := range sortedFakeIDs(f.services) {
+		svc := f.services[id]
+		items = append(items, fakeObject{
+			"id":           svc["id"],
+			"name":         svc["name"],
+			"type":         svc["type"],
+			"url":          svc["url"],
+			"state":        svc["state"],
+			"tags":         svc["tags"],
+			"creationDate": svc["creationDate"],
+			"updateDate":   svc["updateDate"],
+		})
+	}
+	writeFakeJSON(w, http.StatusOK, f.paginate(r, items))
+}
+
+// fakeServiceKind describes how the fake API stores and renders one type of
+// service.
+type fakeServiceKind struct {
+	// fields lists the writable fields the API keeps.
+	fields []string
+	// validate checks the name and every referenced object of a service.
+	validate func(f *fakeBroadpeakAPI, svc fakeObject) (string, int)
+	// expand renders a stored service the way the API returns it, with every
+	// reference resolved to the current state of the referenced object.
+	expand func(f *fakeBroadpeakAPI, svc fakeObject) fakeObject
+}
+
+// fakeServiceKinds lists the service types the fake API can manage.
+var fakeServiceKinds = map[string]fakeServiceKind{
+	"ad-insertion": {
+		fields: []string{
+			"name", "tags", "source", "liveAdPreRoll", "liveAdReplacement", "vodAdInsertion",
+			"transcodingProfile", "advancedOptions", "enableAdTranscoding", "serverSideAdTracking", "state",
+		},
+		validate: (*fakeBroadpeakAPI).validateAdInsertion,
+		expand:   (*fakeBroadpeakAPI).expandAdInsertion,
+	},
+	"content-replacement": {
+		fields:   []string{"name", "tags", "source", "replacement", "transcodingProfile", "environmentTags", "state"},
+		validate: (*fakeBroadpeakAPI).validateContentReplacement,
+		expand:   (*fakeBroadpeakAPI).expandContentReplacement,
+	},
+	"virtual-channel": {
+		fields: []string{
+			"name", "tags", "baseLive", "defaultSlate", "adBreakInsertion", "transcodingProfile",
+			"enableAdTranscoding", "serverSideAdTracking", "environmentTags", "state",
+		},
+		validate: (*fakeBroadpeakAPI).validateVirtualChannel,
+		expand:   (*fakeBroadpeakAPI).expandVirtualChannel,
+	},
+}
+
+func (f *fakeBroadpeakAPI) createService(w http.ResponseWriter, r *http.Request) {
+	kind, ok := fakeServiceKinds[r.PathValue("kind")]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Cannot %s %s", r.Method, r.URL.Path))
+		return
+	}
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc := fakeServiceFields(kind, body)
+	if msg, status := kind.validate(f, svc); msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	now := fakeNow()
+	f.nextID++
+	svc["id"] = f.nextID
+	svc["type"] = r.PathValue("kind")
+	svc["url"] = fmt.Sprintf("https://stream.broadpeak.io/%032x/", f.nextID)
+	svc["creationDate"] = now
+	svc["updateDate"] = now
+	if _, ok := svc["state"]; !ok {
+		svc["state"] = "enabled"
+	}
+	f.services[f.nextID] = svc
+	writeFakeJSON(w, http.StatusCreated, kind.expand(f, svc))
+}
+
+func (f *fakeBroadpeakAPI) getService(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc, ok := f.lookupService(w, r, r.PathValue("kind"))
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, fakeServiceKinds[r.PathValue("kind")].expand(f, svc))
+}
+
+func (f *fakeBroadpeakAPI) updateService(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc, ok := f.lookupService(w, r, r.PathValue("kind"))
+	if !ok {
+		return
+	}
+	kind := fakeServiceKinds[r.PathValue("kind")]
+
+	updated := fakeServiceFields(kind, body)
+	for _, field := range []string{"id", "type", "url", "creationDate"} {
+		updated[field] = svc[field]
+	}
+	if _, ok := updated["state"]; !ok {
+		updated["state"] = svc["state"]
+	}
+	if msg, status := kind.validate(f, updated); msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+	updated["updateDate"] = fakeNow()
+	f.services[fakeID(svc["id"])] = updated
+	writeFakeJSON(w, http.StatusOK, kind.expand(f, updated))
+}
+
+func (f *fakeBroadpeakAPI) deleteService(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc, ok := f.lookupService(w, r, r.PathValue("kind"))
+	if !ok {
+		return
+	}
+	f.removeService(fakeID(svc["id"]))
+	writeFakeJSON(w, http.StatusOK, fakeObject{"message": "Service deleted"})
+}
+
+// removeService deletes a service along with its slots.
+func (f *fakeBroadpeakAPI) removeService(id uint) {
+	delete(f.services, id)
+	for slotID, slot := range f.slots {
+		if fakeID(slot["serviceId"]) == id {
+			delete(f.slots, slotID)
+		}
+	}
+}
+
+// lookupService resolves the {id} path of a request to a stored service of
+// the given type.
+func (f *fakeBroadpeakAPI) lookupService(w http.ResponseWriter, r *http.Request, kind string) (fakeObject, bool) {
+	if _, ok := fakeServiceKinds[kind]; !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Cannot %s %s", r.Method, r.URL.Path))
+		return nil, false
+	}
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	svc, ok := f.services[uint(id)]
+	if !ok || svc["type"] != kind {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Service %d not found", id))
+		return nil, false
+	}
+	return svc, true
+}
+
+// fakeServiceFields copies the writable fields of body into a new service.
+func fakeServiceFields(kind fakeServiceKind, body fakeObject) fakeObject {
+	svc := fakeObject{}
+	for _, field := range kind.fields {
+		if v, ok := body[field]; ok {
+			svc[field] = v
+		}
+	}
+	return svc
+}
+
+// fakeSourceCheck requires a referenced source, when set, to be of one of
+// the given types.
+type fakeSourceCheck struct {
+	id    uint
+	kinds []string
+}
+
+// validateServiceRefs checks the references shared by every service type.
+func (f *fakeBroadpeakAPI) validateServiceRefs(svc fakeObject, checks ...fakeSourceCheck) (string, int) {
+	for _, c := range checks {
+		if c.id == 0 {
+			continue
+		}
+		src, ok := f.sources[c.id]
+		if !ok || !containsFake(c.kinds, src["type"]) {
+			return fmt.Sprintf("You are not allowed to use source %d", c.id), http.StatusForbidden
+		}
+	}
+	if id := fakeRefID(svc, "transcodingProfile"); id != 0 {
+		if _, ok := f.profiles[id]; !ok {
+			return fmt.Sprintf("You are not allowed to use transcoding profile %d", id), http.StatusForbidden
+		}
+	}
+	return "", 0
+}
+
+// validateAdInsertion checks the name and every referenced object of an ad
+// insertion service.
+func (f *fakeBroadpeakAPI) validateAdInsertion(svc fakeObject) (string, int) {
+	name, _ := svc["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return msg, http.StatusBadRequest
+	}
+	if fakeRefID(svc, "source") == 0 {
+		return "source should not be empty", http.StatusBadRequest
+	}
+	return f.validateServiceRefs(svc,
+		fakeSourceCheck{fakeRefID(svc, "source"), []string{"live", "asset", "asset-catalog"}},
+		fakeSourceCheck{fakeRefID(svc, "liveAdReplacement", "adServer"), []string{"ad-server"}},
+		fakeSourceCheck{fakeRefID(svc, "liveAdReplacement", "gapFiller"), []string{"slate", "asset"}},
+		fakeSourceCheck{fakeRefID(svc, "liveAdPreRoll", "adServer"), []string{"ad-server"}},
+		fakeSourceCheck{fakeRefID(svc, "vodAdInsertion", "adServer"), []string{"ad-server"}},
+	)
+}
+
+// validateContentReplacement checks the name and every referenced object of a
+// content replacement service.
+func (f *fakeBroadpeakAPI) validateContentReplacement(svc fakeObject) (string, int) {
+	name, _ := svc["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return msg, http.StatusBadRequest
+	}
+	if fakeRefID(svc, "source") == 0 {
+		return "source should not be empty", http.StatusBadRequest
+	}
+	if fakeRefID(svc, "replacement") == 0 {
+		return "replacement should not be empty", http.StatusBadRequest
+	}
+	return f.validateServiceRefs(svc,
+		fakeSourceCheck{fakeRefID(svc, "source"), []string{"live"}},
+		fakeSourceCheck{fakeRefID(svc, "replacement"), []string{"slate", "asset"}},
+	)
+}
+
+// validateVirtualChannel checks the name and every referenced object of a
+// virtual channel.
+func (f *fakeBroadpeakAPI) validateVirtualChannel(svc fakeObject) (string, int) {
+	name, _ := svc["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return msg, http.StatusBadRequest
+	}
+	if fakeRefID(svc, "baseLive") == 0 {
+		return "baseLive should not be empty", http.StatusBadRequest
+	}
+	if abi, ok := asFakeObject(svc["adBreakInsertion"]); ok && fakeRefID(abi, "adServer") == 0 {
+		return "adBreakInsertion.adServer should not be empty", http.StatusBadRequest
+	}
+	return f.validateServiceRefs(svc,
+		fakeSourceCheck{fakeRefID(svc, "baseLive"), []string{"live"}},
+		fakeSourceCheck{fakeRefID(svc, "defaultSlate"), []string{"slate"}},
+		fakeSourceCheck{fakeRefID(svc, "adBreakInsertion", "adServer"), []string{"ad-server"}},
+		fakeSourceCheck{fakeRefID(svc, "adBreakInsertion", "gapFiller"), []string{"slate", "asset"}},
+	)
+}
+
+// expandService renders the fields shared by every service type.
+func (f *fakeBroadpeakAPI) expandService(svc fakeObject) fakeObject {
+	out := copyFakeObject(svc)
+	if _, ok := out["tags"]; !ok {
+		out["tags"] = []any{}
+	}
+	if id := fakeRefID(svc, "source"); id != 0 {
+		out["source"] = f.sourceRef(id, "id", "name", "description", "backupIp", "multiPeriod", "url", "type", "origin", "format")
+	}
+	if id := fakeRefID(svc, "transcodingProfile"); id != 0 {
+		out["transcodingProfile"] = copyFakeObject(f.profiles[id])
+	}
+	return out
+}
+
+// expandAdInsertion renders a stored ad insertion service.
+func (f *fakeBroadpeakAPI) expandAdInsertion(svc fakeObject) fakeObject {
+	out := f.expandService(svc)
+	if _, ok := out["enableAdTranscoding"]; !ok {
+		out["enableAdTranscoding"] = false
+	}
+	if lar, ok := asFakeObject(svc["liveAdReplacement"]); ok {
+		expanded := fakeObject{"spotAware": fakeObject{"mode": "disabled"}}
+		if sa, ok := asFakeObject(lar["spotAware"]); ok && sa["mode"] != nil && sa["mode"] != "" {
+			expanded["spotAware"] = sa
+		}
+		if id := fakeRefID(svc, "liveAdReplacement", "adServer"); id != 0 {
+			expanded["adServer"] = f.sourceRef(id, "id", "name", "url", "type", "queryParameters")
+		}
+		if id := fakeRefID(svc, "liveAdReplacement", "gapFiller"); id != 0 {
+			expanded["gapFiller"] = f.sourceRef(id, "id", "name", "url", "type")
+		}
+		out["liveAdReplacement"] = expanded
+	}
+	if pre, ok := asFakeObject(svc["liveAdPreRoll"]); ok {
+		expanded := fakeObject{"maxDuration": pre["maxDuration"], "offset": pre["offset"]}
+		if id := fakeRefID(svc, "liveAdPreRoll", "adServer"); id != 0 {
+			expanded["adServer"] = f.sourceRef(id, "id", "name", "url", "type", "queryParameters")
+		}
+		out["liveAdPreRoll"] = expanded
+	}
+	if vod, ok := asFakeObject(svc["vodAdInsertion"]); ok {
+		expanded := copyFakeObject(vod)
+		if id := fakeRefID(svc, "vodAdInsertion", "adServer"); id != 0 {
+			expanded["adServer"] = f.sourceRef(id, "id", "name", "url", "type", "queryParameters")
+		}
+		out["vodAdInsertion"] = expanded
+	}
+	return out
+}
+
+// expandContentReplacement renders a stored content replacement service.
+func (f *fakeBroadpeakAPI) expandContentReplacement(svc fakeObject) fakeObject {
+	out := f.expandService(svc)
+	if _, ok := out["environmentTags"]; !ok {
+		out["environmentTags"] = []any{}
+	}
+	if id := fakeRefID(svc, "replacement"); id != 0 {
+		out["replacement"] = f.sourceRef(id, "id", "name", "description", "url", "type", "format")
+	}
+	return out
+}
+
+// expandVirtualChannel renders a stored virtual channel.
+func (f *fakeBroadpeakAPI) expandVirtualChannel(svc fakeObject) fakeObject {
+	out := f.expandService(svc)
+	if _, ok := out["enableAdTranscoding"]; !ok {
+		out["enableAdTranscoding"] = false
+	}
+	if _, ok := out["serverSideAdTracking"]; !ok {
+		out["serverSideAdTracking"] = fakeObject{"enable": false, "checkAdMediaSegmentAvailability": false}
+	}
+	if id := fakeRefID(svc, "baseLive"); id != 0 {
+		out["baseLive"] = f.sourceRef(id, "id", "name", "description", "multiPeriod", "url", "type", "format")
+	}
+	if id := fakeRefID(svc, "defaultSlate"); id != 0 {
+		out["defaultSlate"] = f.sourceRef(id, "id", "name", "description", "url", "type", "format")
+	}
+	if _, ok := asFakeObject(svc["adBreakInsertion"]); ok {
+		expanded := fakeObject{}
+		if id := fakeRefID(svc, "adBreakInsertion", "adServer"); id != 0 {
+			expanded["adServer"] = f.sourceRef(id, "id", "name", "url", "type", "queryParameters")
+		}
+		if id := fakeRefID(svc, "adBreakInsertion", "gapFiller"); id != 0 {
+			expanded["gapFiller"] = f.sourceRef(id, "id", "name", "url", "type")
+		}
+		out["adBreakInsertion"] = expanded
+	}
+	return out
+}
+
+// sourceRef returns the listed fields of a stored source.
+func (f *fakeBroadpeakAPI) sourceRef(id uint, fields ...string) fakeObject {
+	ref := fakeObject{}
+	src := f.sources[id]
+	for _, field := range fields {
+		if v, ok := src[field]; ok {
+			ref[field] = v
+		}
+	}
+	return ref
+}
+
+// fakeServiceRefs lists the source IDs a stored service depends on.
+func fakeServiceRefs(svc fakeObject) []uint {
+	return []uint{
+		fakeRefID(svc, "source"),
+		fakeRefID(svc, "replacement"),
+		fakeRefID(svc, "baseLive"),
+		fakeRefID(svc, "defaultSlate"),
+		fakeRefID(svc, "adBreakInsertion", "adServer"),
+		fakeRefID(svc, "adBreakInsertion", "gapFiller"),
+		fakeRefID(svc, "liveAdReplacement", "adServer"),
+		fakeRefID(svc, "liveAdReplacement", "gapFiller"),
+		fakeRefID(svc, "liveAdPreRoll", "adServer"),
+		fakeRefID(svc, "vodAdInsertion", "adServer"),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Slots
+// ---------------------------------------------------------------------------
+
+// fakeSlotSources lists, per service type, the source types its slots can
+// play.
+var fakeSlotSources = map[string][]string{
+	"virtual-channel":     {"live", "asset"},
+	"content-replacement": {"slate", "asset"},
+}
+
+func (f *fakeBroadpeakAPI) listSlots(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc, ok := f.lookupSlotService(w, r)
+	if !ok {
+		return
+	}
+	// from and to select the slots that overlap the [from, to) window.
+	from, _ := time.Parse(time.RFC3339, r.URL.Query().Get("from"))
+	to, _ := time.Parse(time.RFC3339, r.URL.Query().Get("to"))
+
+	items := []fakeObject{}
+	for _, id := range sortedFakeIDs(f.slots) {
+		slot := f.slots[id]
+		if slot["serviceId"] != svc["id"] {
+			continue
+		}
+		start, end := fakeSlotSpan(slot)
+		if (!from.IsZero() && !end.After(from)) || (!to.IsZero() && !start.Before(to)) {
+			continue
+		}
+		items = append(items, f.expandSlot(slot))
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		a, _ := fakeSlotSpan(items[i])
+		b, _ := fakeSlotSpan(items[j])
+		return a.Before(b)
+	})
+	writeFakeJSON(w, http.StatusOK, f.paginate(r, items))
+}
+
+func (f *fakeBroadpeakAPI) createSlot(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	svc, ok := f.lookupSlotService(w, r)
+	if !ok {
+		return
+	}
+	slot, msg, status := f.validateSlot(svc, body, 0)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	f.nextID++
+	slot["id"] = f.nextID
+	f.slots[f.nextID] = slot
+	writeFakeJSON(w, http.StatusCreated, f.expandSlot(slot))
+}
+
+func (f *fakeBroadpeakAPI) getSlot(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	slot, ok := f.lookupSlot(w, r)
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, f.expandSlot(slot))
+}
+
+func (f *fakeBroadpeakAPI) updateSlot(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	slot, ok := f.lookupSlot(w, r)
+	if !ok {
+		return
+	}
+	id := fakeID(slot["id"])
+	updated, msg, status := f.validateSlot(f.services[fakeID(slot["serviceId"])], body, id)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	updated["id"] = id
+	f.slots[id] = updated
+	writeFakeJSON(w, http.StatusOK, f.expandSlot(updated))
+}
+
+func (f *fakeBroadpeakAPI) deleteSlot(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	slot, ok := f.lookupSlot(w, r)
+	if !ok {
+		return
+	}
+	delete(f.slots, fakeID(slot["id"]))
+	writeFakeJSON(w, http.StatusOK, fakeObject{"message": "Slot deleted"})
+}
+
+// lookupSlotService resolves the {kind}/{service} path of a request to a
+// stored service that has slots.
+func (f *fakeBroadpeakAPI) lookupSlotService(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	kind := r.PathValue("kind")
+	if _, ok := fakeSlotSources[kind]; !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Cannot %s %s", r.Method, r.URL.Path))
+		return nil, false
+	}
+	id, err := strconv.ParseUint(r.PathValue("service"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	svc, ok := f.services[uint(id)]
+	if !ok || svc["type"] != kind {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Service %d not found", id))
+		return nil, false
+	}
+	return svc, true
+}
+
+// lookupSlot resolves the {kind}/{service}/slots/{id} path of a request to a
+// stored slot.
+func (f *fakeBroadpeakAPI) lookupSlot(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	svc, ok := f.lookupSlotService(w, r)
+	if !ok {
+		return nil, false
+	}
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	slot, ok := f.slots[uint(id)]
+	if !ok || slot["serviceId"] != svc["id"] {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Slot %d not found", id))
+		return nil, false
+	}
+	return slot, true
+}
+
+// validateSlot mimics the checks the API performs before storing a slot, and
+// returns the slot to store with its times normalized to UTC. Virtual channel
+// slots are sized by a duration, content replacement slots by an end time.
+func (f *fakeBroadpeakAPI) validateSlot(svc, body fakeObject, self uint) (fakeObject, string, int) {
+	kind, _ := svc["type"].(string)
+
+	rawStart, _ := body["startTime"].(string)
+	start, err := time.Parse(time.RFC3339, rawStart)
+	if err != nil {
+		return nil, "startTime must be a valid ISO 8601 date string", http.StatusBadRequest
+	}
+	var end time.Time
+	if kind == "content-replacement" {
+		rawEnd, _ := body["endTime"].(string)
+		if end, err = time.Parse(time.RFC3339, rawEnd); err != nil {
+			return nil, "endTime must be a valid ISO 8601 date string", http.StatusBadRequest
+		}
+		if !end.After(start) {
+			return nil, "endTime must be after startTime", http.StatusBadRequest
+		}
+	} else {
+		duration, _ := body["duration"].(float64)
+		if duration < 1 {
+			return nil, "duration must not be less than 1", http.StatusBadRequest
+		}
+		end = start.Add(time.Duration(duration) * time.Second)
+	}
+
+	if fakeRefID(body, "replacement") == 0 {
+		return nil, "replacement should not be empty", http.StatusBadRequest
+	}
+	if msg, status := f.validateServiceRefs(fakeObject{}, fakeSourceCheck{fakeRefID(body, "replacement"), fakeSlotSources[kind]}); msg != "" {
+		return nil, msg, status
+	}
+
+	name, _ := body["name"].(string)
+	slot := fakeObject{
+		"serviceId":   svc["id"],
+		"name":        name,
+		"startTime":   start.UTC().Format(fakeTimeLayout),
+		"endTime":     end.UTC().Format(fakeTimeLayout),
+		"duration":    uint(end.Sub(start).Seconds()),
+		"replacement": fakeObject{"id": fakeRefID(body, "replacement")},
+	}
+	if kind == "virtual-channel" {
+		replay, _ := body["replay"].(bool)
+		slot["replay"] = replay
+		slot["type"] = "content"
+	}
+	if id := fakeRefID(body, "category"); id != 0 {
+		if _, ok := f.categories[id]; !ok {
+			return nil, fmt.Sprintf("You are not allowed to use category %d", id), http.StatusForbidden
+		}
+		slot["category"] = fakeObject{"id": id}
+	}
+
+	for id, other := range f.slots {
+		if id == self || other["serviceId"] != svc["id"] {
+			continue
+		}
+		otherStart, otherEnd := fakeSlotSpan(other)
+		if start.Before(otherEnd) && otherStart.Before(end) {
+			return nil, fmt.Sprintf("Slot overlaps slot %d", id), http.StatusConflict
+		}
+	}
+	return slot, "", 0
+}
+
+// expandSlot renders a stored slot the way the API returns it.
+func (f *fakeBroadpeakAPI) expandSlot(slot fakeObject) fakeObject {
+	out := copyFakeObject(slot)
+	delete(out, "serviceId")
+	out["replacement"] = f.sourceRef(fakeRefID(slot, "replacement"), "id", "name", "description", "url", "type", "format")
+	if id := fakeRefID(slot, "category"); id != 0 {
+		out["category"] = copyFakeObject(f.categories[id])
+	}
+	return out
+}
+
+// fakeSlotSpan returns the start and end of a stored slot.
+func fakeSlotSpan(slot fakeObject) (time.Time, time.Time) {
+	start, _ := time.Parse(time.RFC3339, slot["startTime"].(string))
+	end, _ := time.Parse(time.RFC3339, slot["endTime"].(string))
+	return start, end
+}
+
+// ---------------------------------------------------------------------------
+// Transcoding profiles
+// ---------------------------------------------------------------------------
+
+func (f *fakeBroadpeakAPI) listProfiles(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	items := make([]fakeObject, 0, len(f.profiles))
+	for _, id := range sortedFakeIDs(f.profiles) {
+		items = append(items, copyFakeObject(f.profiles[id]))
+	}
+	writeFakeJSON(w, http.StatusOK, f.paginate(r, items))
+}
+
+func (f *fakeBroadpeakAPI) createProfile(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	profile, msg, status := validateFakeProfile(body)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	f.nextID++
+	profile["id"] = f.nextID
+	profile["internalId"] = fmt.Sprintf("bpk-tp-%d", f.nextID)
+	f.profiles[f.nextID] = profile
+	writeFakeJSON(w, http.StatusCreated, copyFakeObject(profile))
+}
+
+func (f *fakeBroadpeakAPI) getProfile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	profile, ok := f.lookupProfile(w, r)
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(profile))
+}
+
+func (f *fakeBroadpeakAPI) updateProfile(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	profile, ok := f.lookupProfile(w, r)
+	if !ok {
+		return
+	}
+
+	updated, msg, status := validateFakeProfile(body)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+	updated["id"] = profile["id"]
+	updated["internalId"] = profile["internalId"]
+	f.profiles[fakeID(profile["id"])] = updated
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(updated))
+}
+
+func (f *fakeBroadpeakAPI) deleteProfile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	profile, ok := f.lookupProfile(w, r)
+	if !ok {
+		return
+	}
+	id := fakeID(profile["id"])
+	for _, svc := range f.services {
+		if fakeRefID(svc, "transcodingProfile") == id {
+			writeFakeError(w, http.StatusForbidden, "Cannot delete a transcoding profile that is used by a service")
+			return
+		}
+	}
+	delete(f.profiles, id)
+	writeFakeJSON(w, http.StatusOK, fakeObject{"message": "Transcoding profile deleted"})
+}
+
+// lookupProfile resolves the {id} path of a request to a stored transcoding
+// profile.
+func (f *fakeBroadpeakAPI) lookupProfile(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	profile, ok := f.profiles[uint(id)]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Transcoding profile %d not found", id))
+		return nil, false
+	}
+	return profile, true
+}
+
+// validateFakeProfile builds the transcoding profile to store from a request
+// body. Like the API, it stores the content compacted with sorted keys, so
+// that it rarely matches the bytes that were sent.
+func validateFakeProfile(body fakeObject) (fakeObject, string, int) {
+	name, _ := body["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return nil, msg, http.StatusBadRequest
+	}
+	raw, _ := body["content"].(string)
+	var content map[string]any
+	if err := json.Unmarshal([]byte(raw), &content); err != nil || content == nil {
+		return nil, "content must be a JSON string", http.StatusBadRequest
+	}
+	normalized, _ := json.Marshal(content)
+	return fakeObject{"name": name, "content": string(normalized)}, "", 0
+}
+
+// ---------------------------------------------------------------------------
+// Categories
+// ---------------------------------------------------------------------------
+
+func (f *fakeBroadpeakAPI) listCategories(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	items := make([]fakeObject, 0, len(f.categories))
+	for _, id := range sortedFakeIDs(f.categories) {
+		items = append(items, copyFakeObject(f.categories[id]))
+	}
+	writeFakeJSON(w, http.StatusOK, f.paginate(r, items))
+}
+
+func (f *fakeBroadpeakAPI) createCategory(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, msg, status := f.validateCategory(body, 0)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+
+	f.nextID++
+	category["id"] = f.nextID
+	f.categories[f.nextID] = category
+	writeFakeJSON(w, http.StatusCreated, copyFakeObject(category))
+}
+
+func (f *fakeBroadpeakAPI) getCategory(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, ok := f.lookupCategory(w, r)
+	if !ok {
+		return
+	}
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(category))
+}
+
+func (f *fakeBroadpeakAPI) updateCategory(w http.ResponseWriter, r *http.Request) {
+	body, ok := decodeFakeBody(w, r)
+	if !ok {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, ok := f.lookupCategory(w, r)
+	if !ok {
+		return
+	}
+	id := fakeID(category["id"])
+
+	updated, msg, status := f.validateCategory(body, id)
+	if msg != "" {
+		writeFakeError(w, status, msg)
+		return
+	}
+	updated["id"] = id
+	f.categories[id] = updated
+	writeFakeJSON(w, http.StatusOK, copyFakeObject(updated))
+}
+
+func (f *fakeBroadpeakAPI) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	category, ok := f.lookupCategory(w, r)
+	if !ok {
+		return
+	}
+	id := fakeID(category["id"])
+	for _, slot := range f.slots {
+		if fakeRefID(slot, "category") == id {
+			writeFakeError(w, http.StatusForbidden, "Cannot delete a category that is used by a slot")
+			return
+		}
+	}
+	delete(f.categories, id)
+	writeFakeJSON(w, http.StatusOK, fakeObject{"message": "Category deleted"})
+}
+
+// lookupCategory resolves the {id} path of a request to a stored category.
+func (f *fakeBroadpeakAPI) lookupCategory(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Validation failed (numeric string is expected)")
+		return nil, false
+	}
+	category, ok := f.categories[uint(id)]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, fmt.Sprintf("Category %d not found", id))
+		return nil, false
+	}
+	return category, true
+}
+
+// validateCategory builds the category to store from a request body. Names
+// are unique within a tenant, and omitted subcategories are stored as none.
+func (f *fakeBroadpeakAPI) validateCategory(body fakeObject, self uint) (fakeObject, string, int) {
+	name, _ := body["name"].(string)
+	if msg := validateFakeName(name); msg != "" {
+		return nil, msg, http.StatusBadRequest
+	}
+	for id, other := range f.categories {
+		if id != self && other["name"] == name {
+			return nil, fmt.Sprintf("Category %s already exists", name), http.StatusForbidden
+		}
+	}
+
+	raw, _ := body["subcategories"].([]any)
+	subcategories := make([]any, 0, len(raw))
+	for _, item := range raw {
+		sub, _ := asFakeObject(item)
+		key, _ := sub["key"].(string)
+		value, _ := sub["value"].(string)
+		if key == "" || value == "" {
+			return nil, "subcategories must have a key and a value", http.StatusBadRequest
+		}
+		subcategories = append(subcategories, fakeObject{"key": key, "value": value})
+	}
+	return fakeObject{"name": name, "subcategories": subcategories}, "", 0
+}
+
+// ---------------------------------------------------------------------------
+// Tenant
+// ---------------------------------------------------------------------------
+
+func (f *fakeBroadpeakAPI) getTenant(w http.ResponseWriter, _ *http.Request) {
+	writeFakeJSON(w, http.StatusOK, fakeObject{
+		"id":             fakeTenantID,
+		"name":           "tf-acc-tenant",
+		"description":    "Tenant served by the fake Broadpeak API",
+		"commercialPlan": "ENTERPRISE",
+		"state":          "enabled",
+		"creationDate":   "2024-01-01T00:00:00.000Z",
+		"updateDate":     "2024-01-01T00:00:00.000Z",
+	})
+}
+
+func (f *fakeBroadpeakAPI) getCurrentUser(w http.ResponseWriter, _ *http.Request) {
+	writeFakeJSON(w, http.StatusOK, fakeObject{
+		"id":           42,
+		"firstName":    "Terraform",
+		"lastName":     "Acceptance",
+		"email":        "tf-acc@example.com",
+		"tenantId":     fakeTenantID,
+		"creationDate": "2024-01-01T00:00:00.000Z",
+		"updateDate":   "2024-01-01T00:00:00.000Z",
+	})
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// writeFakeError writes an error body shaped like the ones the API returns.
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, fakeObject{
+		"statusCode": status,
+		"message":    message,
+		"error":      http.StatusText(status),
+	})
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func decodeFakeBody(w http.ResponseWriter, r *http.Request) (fakeObject, bool) {
+	var body fakeObject
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeFakeError(w, http.StatusBadRequest, "Unexpected token in JSON body")
+		return nil, false
+	}
+	return body, true
+}
+
+// validateFakeName applies the API's rules on object names.
+func validateFakeName(name string) string {
+	if name == "" {
+		return "name should not be empty"
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return "name must be shorter than or equal to 100 characters"
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) {
+			return "name contains invalid characters"
+		}
+	}
+	return ""
+}
+
+// paginate applies the offset and limit query parameters to a listing, with
+// limit capped at pageLimit when it is set. It is called with f.mu held.
+func (f *fakeBroadpeakAPI) paginate(r *http.Request, items []fakeObject) []fakeObject {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	if f.pageLimit > 0 {
+		limit = min(limit, f.pageLimit)
+	}
+	if offset >= len(items) {
+		return []fakeObject{}
+	}
+	end := min(offset+limit, len(items))
+	return items[offset:end]
+}
+
+// fakeRefID reads the id of a nested {"id": ...} reference.
+func fakeRefID(obj map[string]any, keys ...string) uint {
+	cur := obj
+	for _, key := range keys {
+		next, ok := asFakeObject(cur[key])
+		if !ok {
+			return 0
+		}
+		cur = next
+	}
+	return fakeID(cur["id"])
+}
+
+// asFakeObject accepts both decoded JSON objects and stored fake objects.
+func asFakeObject(v any) (map[string]any, bool) {
+	switch obj := v.(type) {
+	case map[string]any:
+		return obj, true
+	case fakeObject:
+		return obj, true
+	}
+	return nil, false
+}
+
+// fakeID converts a decoded JSON number to an object ID.
+func fakeID(v any) uint {
+	switch n := v.(type) {
+	case float64:
+		return uint(n)
+	case uint:
+		return n
+	case int:
+		return uint(n)
+	}
+	return 0
+}
+
+func copyFakeObject(obj map[string]any) fakeObject {
+	out := make(fakeObject, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	return out
+}
+
+func sortedFakeIDs(objects map[uint]fakeObject) []uint {
+	ids := make([]uint, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func containsFake(values []string, v any) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// fakeTimeLayout is the format of the timestamps the API returns.
+const fakeTimeLayout = "2006-01-02T15:04:05.000Z"
+
+func fakeNow() string {
+	return time.Now().UTC().Format(fakeTimeLayout)
+}
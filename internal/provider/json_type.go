@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = jsonStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonString{}
+	_ planmodifier.String                        = jsonSemanticEquality{}
+)
+
+// jsonStringType is the type of string attributes that hold a JSON document.
+// Its values are compared semantically, so that a document the API returns
+// reformatted or with other key order does not show up as a diff.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) ValueType(_ context.Context) attr.Value {
+	return jsonString{}
+}
+
+func (t jsonStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonString{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return jsonString{StringValue: stringValue}, nil
+}
+
+// jsonString is a string attribute value that holds a JSON document.
+type jsonString struct {
+	basetypes.StringValue
+}
+
+// newJSONString returns a known JSON document value.
+func newJSONString(value string) jsonString {
+	return jsonString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonString) Type(_ context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+func (v jsonString) Equal(o attr.Value) bool {
+	other, ok := o.(jsonString)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values are the same JSON
+// document, regardless of formatting and key order. Values that are not
+// valid JSON are never semantically equal.
+func (v jsonString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonString)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return sameJSON(v.ValueString(), newValue.ValueString()), diags
+}
+
+// sameJSON reports whether two strings are the same JSON document, regardless
+// of formatting and key order.
+func sameJSON(a, b string) bool {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// jsonSemanticEquality plans the prior state value of a JSON attribute when
+// the configured document only differs from it by formatting or key order.
+// Semantic equality only applies to values returned by the provider; this
+// extends it to configuration changes.
+type jsonSemanticEquality struct{}
+
+func (m jsonSemanticEquality) Description(_ context.Context) string {
+	return "Formatting and key order of the JSON document are not significant."
+}
+
+func (m jsonSemanticEquality) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m jsonSemanticEquality) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+		return
+	}
+	if sameJSON(req.StateValue.ValueString(), req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONStringSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal, diags := newJSONString(`{"a": 1, "b": [true, null]}`).StringSemanticEquals(ctx, newJSONString(`{"b":[true,null],"a":1}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, _ = newJSONString(`{"b": [1, 2]}`).StringSemanticEquals(ctx, newJSONString(`{"b": [2, 1]}`))
+	assert.False(t, equal)
+
+	// Invalid documents are only ever equal byte for byte.
+	equal, _ = newJSONString(`{"a": `).StringSemanticEquals(ctx, newJSONString(`{"a":`))
+	assert.False(t, equal)
+
+	_, diags = newJSONString(`{}`).StringSemanticEquals(ctx, types.StringValue(`{}`))
+	assert.True(t, diags.HasError())
+}
+
+func TestSameJSON(t *testing.T) {
+	assert.True(t, sameJSON(`{"a": 1, "b": [true, null]}`, `{"b":[true,null],"a":1.0}`))
+	assert.False(t, sameJSON(`{"a": 1}`, `{"a": "1"}`))
+	assert.False(t, sameJSON(`{"b": [1, 2]}`, `{"b": [2, 1]}`))
+	assert.False(t, sameJSON("", `{}`))
+}
+
+func TestJSONStringType(t *testing.T) {
+	ctx := context.Background()
+
+	v, diags := jsonStringType{}.ValueFromString(ctx, types.StringValue(`{}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, v.(jsonString).Equal(newJSONString(`{}`)))
+	assert.False(t, newJSONString(`{}`).Equal(types.StringValue(`{}`)))
+	assert.True(t, newJSONString(`{}`).Type(ctx).Equal(jsonStringType{}))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+)
+
+// listCache is a read-through cache of the response bodies of list calls.
+// It lives as long as the client, that is for one Terraform operation, so
+// that the data sources and lookups of a plan share the full-tenant listings
+// instead of each fetching them again. Concurrent misses for the same key are
+// collapsed into one request.
+//
+// Any write through the client invalidates the whole cache: listings read
+// afterwards reflect the objects the operation created, updated or deleted.
+type listCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	// generation is bumped on every invalidation, so that a listing fetched
+	// before a write is neither stored nor shared with later callers.
+	generation uint64
+	group      singleflight.Group
+}
+
+// newListCache returns an empty listCache.
+func newListCache() *listCache {
+	return &listCache{entries: map[string][]byte{}}
+}
+
+// get returns the cached body for key, or calls load to fetch it. Failed
+// loads are not cached. A caller waiting on the load of another one stops as
+// soon as its own ctx is done, and loads again itself if the other caller
+// gave up instead.
+func (c *listCache) get(ctx context.Context, key string, load func() ([]byte, error)) ([]byte, error) {
+	for {
+		c.mu.Lock()
+		if body, ok := c.entries[key]; ok {
+			c.mu.Unlock()
+			tflog.Trace(ctx, "Listing served from cache", map[string]interface{}{"key": key})
+			return body, nil
+		}
+		generation := c.generation
+		c.mu.Unlock()
+
+		ch := c.group.DoChan(fmt.Sprintf("%d:%s", generation, key), func() (any, error) {
+			body, err := load()
+			if err != nil {
+				return nil, err
+			}
+
+			c.mu.Lock()
+			if c.generation == generation {
+				c.entries[key] = body
+			}
+			c.mu.Unlock()
+			return body, nil
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-ch:
+			var ie *interruptedError
+			if errors.As(res.Err, &ie) && ctx.Err() == nil {
+				tflog.Debug(ctx, "Shared listing was abandoned by another caller, loading it again", map[string]interface{}{"key": key})
+				continue
+			}
+			if res.Err != nil {
+				return nil, res.Err
+			}
+			return res.Val.([]byte), nil
+		}
+	}
+}
+
+// invalidate drops every cached listing.
+func (c *listCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string][]byte{}
+	c.generation++
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCache_ReadThrough(t *testing.T) {
+	cache := newListCache()
+	calls := 0
+	load := func() ([]byte, error) {
+		calls++
+		return []byte("[]"), nil
+	}
+
+	for range 3 {
+		body, err := cache.get(context.Background(), "sources", load)
+		require.NoError(t, err)
+		require.Equal(t, "[]", string(body))
+	}
+	require.Equal(t, 1, calls)
+
+	_, err := cache.get(context.Background(), "services", load)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls, "keys are cached separately")
+
+	cache.invalidate()
+	_, err = cache.get(context.Background(), "sources", load)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls, "invalidate drops cached listings")
+}
+
+func TestListCache_ErrorsAreNotCached(t *testing.T) {
+	cache := newListCache()
+	calls := 0
+	load := func() ([]byte, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("boom")
+		}
+		return []byte("[]"), nil
+	}
+
+	_, err := cache.get(context.Background(), "sources", load)
+	require.EqualError(t, err, "boom")
+	_, err = cache.get(context.Background(), "sources", load)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestListCache_ConcurrentMissesShareOneLoad(t *testing.T) {
+	cache := newListCache()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	load := func() ([]byte, error) {
+		calls.Add(1)
+		<-release
+		return []byte("[]"), nil
+	}
+
+	var wg sync.WaitGroup
+	var started atomic.Int32
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			started.Add(1)
+			_, err := cache.get(context.Background(), "sources", load)
+			require.NoError(t, err)
+		}()
+	}
+	// Let the callers pile up on the first load before it completes.
+	require.Eventually(t, func() bool { return started.Load() == 10 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestListCache_InvalidateDuringLoad(t *testing.T) {
+	cache := newListCache()
+	calls := 0
+	load := func() ([]byte, error) {
+		calls++
+		if calls == 1 {
+			// A write completes while the first listing is in flight.
+			cache.invalidate()
+		}
+		return []byte("[]"), nil
+	}
+
+	_, err := cache.get(context.Background(), "sources", load)
+	require.NoError(t, err)
+	_, err = cache.get(context.Background(), "sources", load)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls, "a listing fetched before a write is not kept")
+}
+
+func TestBpkioClient_ListCache(t *testing.T) {
+	var lists atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			lists.Add(1)
+			_, _ = w.Write([]byte(`[{"id":7,"name":"slate","type":"slate"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":8,"name":"new","type":"slate"}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	first, err := client.GetAllSources(ctx, 0, 100)
+	require.NoError(t, err)
+	second, err := client.GetAllSources(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), lists.Load())
+	require.Equal(t, first, second)
+
+	// Callers decode their own copy of the cached listing.
+	first[0].Name = "changed"
+	second, err = client.GetAllSources(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, "slate", second[0].Name)
+
+	_, err = client.GetAllSources(ctx, 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), lists.Load(), "other pages are other keys")
+
+	_, err = client.CreateSlate(ctx, broadpeakio.SlateInput{Name: "new"})
+	require.NoError(t, err)
+	_, err = client.GetAllSources(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), lists.Load(), "writes invalidate the cache")
+}
+
+func TestListCache_WaiterStopsOnItsContext(t *testing.T) {
+	cache := newListCache()
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_, _ = cache.get(context.Background(), "sources", func() ([]byte, error) {
+			<-release
+			return []byte("[]"), nil
+		})
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := cache.get(ctx, "sources", func() ([]byte, error) {
+		t.Error("the in-flight load is shared")
+		return nil, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestListCache_AbandonedLoadIsRetried(t *testing.T) {
+	cache := newListCache()
+	loading := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		_, _ = cache.get(ctx, "sources", func() ([]byte, error) {
+			close(loading)
+			<-ctx.Done()
+			return nil, &interruptedError{Method: http.MethodGet, Path: "sources", Err: ctx.Err()}
+		})
+	}()
+	<-loading
+
+	done := make(chan error)
+	go func() {
+		body, err := cache.get(context.Background(), "sources", func() ([]byte, error) {
+			return []byte("[]"), nil
+		})
+		if err == nil && string(body) != "[]" {
+			err = errors.New("unexpected body " + string(body))
+		}
+		done <- err
+	}()
+	// The caller that started the load gives up while another one waits.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	require.NoError(t, <-done, "a caller whose context is live loads again")
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// namedObject is an object of a listing, as seen by a lookup by name.
+type namedObject struct {
+	ID   uint
+	Name string
+}
+
+// lookupIDAttribute is the id lookup key of a singular data source, exclusive
+// with lookupNameAttribute.
+func lookupIDAttribute(what string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("ID of the %s. Exactly one of `id` and `name` must be set.", what),
+		Validators: []validator.Int64{
+			int64validator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+}
+
+// lookupNameAttribute is the name lookup key of a singular data source. The
+// lookup fails unless exactly one object has this name.
+func lookupNameAttribute(what string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: fmt.Sprintf("Name of the %s. Exactly one of `id` and `name` must be set; a lookup by name fails unless exactly one %s has this name.", what, what),
+	}
+}
+
+// namedObjectLister lists the objects a singular data source can look up.
+type namedObjectLister func(ctx context.Context) ([]namedObject, error)
+
+// lookupID returns the ID of the object a singular data source reads: the
+// configured id, or else the ID of the only listed object with the configured
+// name. what names the kind of object in diagnostics, e.g. "live source".
+func lookupID(ctx context.Context, id types.Int64, name types.String, what string, list namedObjectLister) (uint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !id.IsNull() {
+		return uint(id.ValueInt64()), diags
+	}
+
+	objects, err := list(ctx)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to Look Up %s", titleCase(what)),
+			fmt.Sprintf("Could not list the %ss to find %q: %s", what, name.ValueString(), err),
+		)
+		return 0, diags
+	}
+
+	var matches []string
+	var found uint
+	for _, o := range objects {
+		if o.Name == name.ValueString() {
+			found = o.ID
+			matches = append(matches, fmt.Sprint(o.ID))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("%s Not Found", titleCase(what)),
+			fmt.Sprintf("No %s is named %q.", what, name.ValueString()),
+		)
+	case 1:
+		return found, diags
+	default:
+		diags.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("Ambiguous %s Name", titleCase(what)),
+			fmt.Sprintf("%d %ss are named %q (IDs %s). Set id instead to pick one.", len(matches), what, name.ValueString(), strings.Join(matches, ", ")),
+		)
+	}
+	return 0, diags
+}
+
+// titleCase capitalizes every word of s, for diagnostic summaries.
+func titleCase(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// namedSources lists the sources of the given type.
+func (c *bpkioClient) namedSources(sourceType string) namedObjectLister {
+	return func(ctx context.Context) ([]namedObject, error) {
+		sources, _, err := listAll(ctx, c.pageSize, 0, c.GetAllSources, func(s sourceListOutput) bool {
+			return s.Type == sourceType
+		})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]namedObject, 0, len(sources))
+		for _, s := range sources {
+			out = append(out, namedObject{ID: s.Id, Name: s.Name})
+		}
+		return out, nil
+	}
+}
+
+// namedServices lists the services of the given type.
+func (c *bpkioClient) namedServices(serviceType string) namedObjectLister {
+	return func(ctx context.Context) ([]namedObject, error) {
+		services, _, err := listAll(ctx, c.pageSize, 0, c.GetAllServices, func(s broadpeakio.ServiceOutput) bool {
+			return s.Type == serviceType
+		})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]namedObject, 0, len(services))
+		for _, s := range services {
+			out = append(out, namedObject{ID: s.Id, Name: s.Name})
+		}
+		return out, nil
+	}
+}
+
+// namedTranscodingProfiles lists the transcoding profiles.
+func (c *bpkioClient) namedTranscodingProfiles(ctx context.Context) ([]namedObject, error) {
+	profiles, _, err := listAll(ctx, c.pageSize, 0, c.GetAllTranscodingProfiles, nil)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]namedObject, 0, len(profiles))
+	for _, p := range profiles {
+		out = append(out, namedObject{ID: p.Id, Name: p.Name})
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLookupID(t *testing.T) {
+	objects := []namedObject{
+		{ID: 1, Name: "main"},
+		{ID: 2, Name: "backup"},
+		{ID: 3, Name: "backup"},
+	}
+	list := func(context.Context) ([]namedObject, error) { return objects, nil }
+
+	testCases := []struct {
+		name    string
+		id      types.Int64
+		lookup  types.String
+		list    namedObjectLister
+		want    uint
+		wantErr string
+	}{
+		{
+			name:   "by id, without listing",
+			id:     types.Int64Value(7),
+			lookup: types.StringNull(),
+			list: func(context.Context) ([]namedObject, error) {
+				t.Fatal("unexpected listing")
+				return nil, nil
+			},
+			want: 7,
+		},
+		{
+			name:   "by name",
+			id:     types.Int64Null(),
+			lookup: types.StringValue("main"),
+			list:   list,
+			want:   1,
+		},
+		{
+			name:    "no match",
+			id:      types.Int64Null(),
+			lookup:  types.StringValue("other"),
+			list:    list,
+			wantErr: `No live source is named "other".`,
+		},
+		{
+			name:    "several matches",
+			id:      types.Int64Null(),
+			lookup:  types.StringValue("backup"),
+			list:    list,
+			wantErr: `2 live sources are named "backup" (IDs 2, 3).`,
+		},
+		{
+			name:   "listing fails",
+			id:     types.Int64Null(),
+			lookup: types.StringValue("main"),
+			list: func(context.Context) ([]namedObject, error) {
+				return nil, errors.New("boom")
+			},
+			wantErr: "boom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := lookupID(context.Background(), tc.id, tc.lookup, "live source", tc.list)
+			if tc.wantErr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				if got != tc.want {
+					t.Errorf("expected ID %d, got %d", tc.want, got)
+				}
+				return
+			}
+			if !diags.HasError() {
+				t.Fatalf("expected an error, got ID %d", got)
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tc.wantErr) {
+				t.Errorf("expected detail containing %q, got %q", tc.wantErr, detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultPageSize is the number of items requested per page of a listing.
+const defaultPageSize = 100
+
+// pageFetcher fetches limit items of a listing, starting at offset.
+type pageFetcher[T any] func(ctx context.Context, offset, limit uint) ([]T, error)
+
+// listStatus reports how listAll walked a listing.
+type listStatus struct {
+	// truncated is set when maxResults stopped the walk before the end of
+	// the listing.
+	truncated bool
+	// pageCap is the largest page the API served when a short page was
+	// followed by more items, i.e. when the API caps the page size below the
+	// one requested. It is zero otherwise.
+	pageCap int
+}
+
+// listAll walks a listing page by page until an empty page marks its end, and
+// returns the items keep selects; a nil keep selects them all. Each page
+// starts where the previous one ended, so an API that serves fewer items than
+// requested is still read in full. When maxResults is above zero, it stops at
+// the first selected item past maxResults and reports the listing as
+// truncated.
+func listAll[T any](ctx context.Context, pageSize, maxResults int, fetch pageFetcher[T], keep func(T) bool) ([]T, listStatus, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var (
+		items   []T
+		status  listStatus
+		longest int
+		short   bool
+	)
+	for offset := 0; ; {
+		page, err := fetch(ctx, uint(offset), uint(pageSize))
+		if err != nil {
+			return nil, listStatus{}, err
+		}
+		if len(page) == 0 {
+			return items, status, nil
+		}
+
+		longest = max(longest, len(page))
+		if short {
+			status.pageCap = longest
+		}
+		short = len(page) < pageSize
+		offset += len(page)
+
+		for _, item := range page {
+			if keep != nil && !keep(item) {
+				continue
+			}
+			if maxResults > 0 && len(items) == maxResults {
+				status.truncated = true
+				return items, status, nil
+			}
+			items = append(items, item)
+		}
+	}
+}
+
+// truncateResults caps items at maxResults when it is above zero, and
+// reports whether any were dropped.
+func truncateResults[T any](items []T, maxResults int) ([]T, bool) {
+	if maxResults > 0 && len(items) > maxResults {
+		return items[:maxResults], true
+	}
+	return items, false
+}
+
+// maxResultsAttribute caps the number of objects a list data source returns,
+// taken in the given order.
+func maxResultsAttribute(what, order string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Description: fmt.Sprintf("Maximum number of %s to list, %s. When more match, "+
+			"the first ones are listed and a warning is returned. Unlimited by default.", what, order),
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// addListWarnings warns that a list data source returned maxResults objects
+// while more matched, and that the API served smaller pages than
+// list_page_size asks for.
+func addListWarnings(diags *diag.Diagnostics, what string, maxResults types.Int64, status listStatus) {
+	if status.truncated {
+		diags.AddAttributeWarning(
+			path.Root("max_results"),
+			"Results Truncated",
+			fmt.Sprintf("More than %d %s match; only the first %d are listed. Raise max_results or narrow the filters to list them all.",
+				maxResults.ValueInt64(), what, maxResults.ValueInt64()),
+		)
+	}
+	if status.pageCap > 0 {
+		diags.AddWarning(
+			"Listing Page Size Capped",
+			fmt.Sprintf("The bpkio API returned at most %d %s per page, fewer than the provider list_page_size setting requests. "+
+				"Every page was read, but %s created or deleted while paging may have been missed or listed twice. "+
+				"Set list_page_size to %d or lower to match the API.", status.pageCap, what, what, status.pageCap),
+		)
+	}
+}
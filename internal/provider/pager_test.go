@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAll(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6}
+	even := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name          string
+		pageSize      int
+		serverLimit   int
+		maxResults    int
+		keep          func(int) bool
+		want          []int
+		wantTruncated bool
+		wantPageCap   int
+		wantCalls     int
+	}{
+		{name: "single page", pageSize: 10, want: items, wantCalls: 2},
+		{name: "every page", pageSize: 4, want: items, wantCalls: 3},
+		{name: "exact multiple", pageSize: 3, want: items, wantCalls: 3},
+		{name: "default page size", pageSize: 0, want: items, wantCalls: 2},
+		{name: "keep", pageSize: 2, keep: even, want: []int{2, 4, 6}, wantCalls: 4},
+		{name: "truncated", pageSize: 2, maxResults: 3, want: []int{1, 2, 3}, wantTruncated: true, wantCalls: 2},
+		{name: "truncated after keep", pageSize: 2, maxResults: 2, keep: even, want: []int{2, 4}, wantTruncated: true, wantCalls: 3},
+		{name: "max results not reached", pageSize: 4, maxResults: 6, want: items, wantCalls: 3},
+		{name: "server caps the page size", pageSize: 4, serverLimit: 2, want: items, wantPageCap: 2, wantCalls: 4},
+		{name: "server limit at the page size", pageSize: 4, serverLimit: 4, want: items, wantCalls: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			fetch := func(_ context.Context, offset, limit uint) ([]int, error) {
+				calls++
+				if tt.serverLimit > 0 {
+					limit = min(limit, uint(tt.serverLimit))
+				}
+				end := min(int(offset+limit), len(items))
+				if int(offset) >= end {
+					return nil, nil
+				}
+				return items[offset:end], nil
+			}
+
+			got, status, err := listAll(context.Background(), tt.pageSize, tt.maxResults, fetch, tt.keep)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantTruncated, status.truncated)
+			require.Equal(t, tt.wantPageCap, status.pageCap)
+			require.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	fetch := func(_ context.Context, offset, _ uint) ([]int, error) {
+		if offset > 0 {
+			return nil, errors.New("boom")
+		}
+		return []int{1, 2}, nil
+	}
+
+	_, _, err := listAll(context.Background(), 2, 0, fetch, nil)
+	require.EqualError(t, err, "boom")
+}
+
+func TestAddListWarnings(t *testing.T) {
+	var diags diag.Diagnostics
+	addListWarnings(&diags, "sources", types.Int64Null(), listStatus{})
+	require.Empty(t, diags)
+
+	addListWarnings(&diags, "sources", types.Int64Value(10), listStatus{truncated: true, pageCap: 50})
+	require.Len(t, diags, 2)
+	require.Equal(t, "Results Truncated", diags[0].Summary())
+	require.Equal(t, "Listing Page Size Capped", diags[1].Summary())
+	require.Contains(t, diags[1].Detail(), "at most 50 sources per page")
+}
+
+func TestTruncateResults(t *testing.T) {
+	got, truncated := truncateResults([]int{3, 1, 2}, 2)
+	require.Equal(t, []int{3, 1}, got)
+	require.True(t, truncated)
+
+	got, truncated = truncateResults([]int{3, 1, 2}, 3)
+	require.Equal(t, []int{3, 1, 2}, got)
+	require.False(t, truncated)
+
+	got, truncated = truncateResults([]int{3, 1, 2}, 0)
+	require.Equal(t, []int{3, 1, 2}, got)
+	require.False(t, truncated)
+}
@@ -5,15 +5,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
-	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -60,21 +65,68 @@ func (p *bpkioProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
 				Optional:    true,
-				Description: "The Broadpeak API endpoint. Defaults to `https://api.broadpeak.io`.",
+				Description: "The Broadpeak API endpoint, as an absolute http or https URL. Can also be set with the `BPKIO_ENDPOINT` environment variable. Defaults to `https://api.broadpeak.io`.",
 			},
 			"api_key": schema.StringAttribute{
 				Required:    true,
 				Description: "API key for Broadpeak",
 				Sensitive:   true,
 			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the API call that validates the API key when the provider is configured. Defaults to `false`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of times a request is retried when the API rate limits it (HTTP 429), or when an idempotent request fails with a server or network error. Set to `0` to disable retries. Defaults to `4`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time to wait before retrying a request, as a duration such as `500ms` or `2s`. The wait doubles, with jitter, on each retry up to `retry_wait_max`. A `Retry-After` header sent by the API takes precedence. Defaults to `1s`.",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait before retrying a request, as a duration such as `30s` or `1m`. Defaults to `30s`.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests the provider sends at once, across all resources and data sources. Defaults to `10`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests the provider sends per second, across all resources and data sources. Retries count against the limit. Unlimited by default.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"list_page_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Number of items requested per page when data sources list sources, services, transcoding profiles or categories. Every page is read. Defaults to `100`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
 
 // bpkioProviderModel maps provider schema data to a Go type.
 type bpkioProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiKey   types.String `tfsdk:"api_key"`
+	Endpoint                  types.String  `tfsdk:"endpoint"`
+	ApiKey                    types.String  `tfsdk:"api_key"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin              types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax              types.String  `tfsdk:"retry_wait_max"`
+	MaxConcurrentRequests     types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond         types.Float64 `tfsdk:"requests_per_second"`
+	ListPageSize              types.Int64   `tfsdk:"list_page_size"`
 }
 
 // Configure prepares a bpkio API client for data sources and resources.
@@ -108,6 +160,60 @@ func (p *bpkioProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown bpkio Maximum Retries",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for max_retries. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryWaitMin.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Unknown bpkio Minimum Retry Wait",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for retry_wait_min. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RetryWaitMax.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Unknown bpkio Maximum Retry Wait",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for retry_wait_max. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown bpkio Maximum Concurrent Requests",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for max_concurrent_requests. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown bpkio Requests Per Second",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for requests_per_second. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ListPageSize.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("list_page_size"),
+			"Unknown bpkio List Page Size",
+			"The provider cannot create the bpkio API client as there is an unknown configuration value for list_page_size. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -115,7 +221,7 @@ func (p *bpkioProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	// Default values to environment variables, but override
 	// with Terraform configuration value if set.
 
-	endpoint := getenv("BPKIO_ENDPOINT", "https://api.broadpeak.io")
+	endpoint := getenv("BPKIO_ENDPOINT", defaultEndpoint)
 	api_key := getenv("BPKIO_API_KEY", "")
 
 	if !config.Endpoint.IsNull() {
@@ -126,7 +232,7 @@ func (p *bpkioProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		api_key = config.ApiKey.ValueString()
 	}
 
-	tflog.Debug(ctx, endpoint)
+	tflog.Debug(ctx, "Configuring bpkio client", map[string]interface{}{"endpoint": endpoint})
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -144,23 +250,87 @@ func (p *bpkioProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	retry := defaultRetryPolicy
+	if !config.MaxRetries.IsNull() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	retry.WaitMin = parseRetryWait(config.RetryWaitMin, "retry_wait_min", retry.WaitMin, &resp.Diagnostics)
+	retry.WaitMax = parseRetryWait(config.RetryWaitMax, "retry_wait_max", retry.WaitMax, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if retry.WaitMin > retry.WaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Wait",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).", retry.WaitMin, retry.WaitMax),
+		)
+		return
+	}
+
+	opts := []clientOption{withRetryPolicy(retry)}
+	if !config.MaxConcurrentRequests.IsNull() {
+		opts = append(opts, withConcurrencyLimit(int(config.MaxConcurrentRequests.ValueInt64())))
+	}
+	if !config.RequestsPerSecond.IsNull() {
+		opts = append(opts, withRequestsPerSecond(config.RequestsPerSecond.ValueFloat64()))
+	}
+	if !config.ListPageSize.IsNull() {
+		opts = append(opts, withPageSize(int(config.ListPageSize.ValueInt64())))
+	}
+
 	// Create a new bpkio client using the configuration values
-	//TODO: Find a way to test key
-	client := broadpeakio.MakeClient(api_key)
-	//if err != nil {
-	//	resp.Diagnostics.AddError(
-	//		"Unable to Create bpkio API Client",
-	//		"An unexpected error occurred when creating the bpkio API client. "+
-	//			"If the error is not clear, please contact the provider developers.\n\n"+
-	//			"bpkio Client Error: "+err.Error(),
-	//	)
-	//	return
-	//}
+	client, err := newBpkioClient(endpoint, api_key, opts...)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid bpkio API Endpoint",
+			fmt.Sprintf("The provider cannot create the bpkio API client as the endpoint %q is not a valid API URL: %s. "+
+				"Set an absolute http or https URL, such as %s, in the configuration or in the BPKIO_ENDPOINT environment variable.", endpoint, err, defaultEndpoint),
+		)
+		return
+	}
+
+	// Fail fast on a bad or revoked key, rather than on the first resource
+	// that happens to call the API.
+	if !config.SkipCredentialsValidation.ValueBool() {
+		tenant, err := client.GetTenant(ctx)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key"),
+				"Unable to Validate bpkio API Key",
+				fmt.Sprintf("The provider could not authenticate against %s with the configured API key. "+
+					"Check that the key is valid and has not been revoked, or set skip_credentials_validation = true to skip this check.\n\n"+
+					"bpkio Client Error: %s", endpoint, err),
+			)
+			return
+		}
+		tflog.Debug(ctx, "Validated bpkio API key", map[string]interface{}{"tenant_id": tenant.Id, "tenant_name": tenant.Name})
+	}
 
 	// Make the bpkio client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = &client
-	resp.ResourceData = &client
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+// parseRetryWait returns the duration configured in value, or fallback when
+// it is not set.
+func parseRetryWait(value types.String, attribute string, fallback time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return fallback
+	}
+
+	wait, err := time.ParseDuration(value.ValueString())
+	if err != nil || wait < 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Retry Wait",
+			fmt.Sprintf("%s must be a non-negative duration such as \"500ms\" or \"2s\", got %q.", attribute, value.ValueString()),
+		)
+		return fallback
+	}
+	return wait
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -170,10 +340,15 @@ func (p *bpkioProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewSourceAdServerDataSource,
 		NewSourceSlateDataSource,
 		NewSourceLiveDataSource,
+		NewSourceAssetDataSource,
+		NewSourceAssetCatalogDataSource,
 		NewServiceAdInsertionDataSource,
+		NewServiceContentReplacementDataSource,
 		NewServicesDataSource,
 		NewTranscodingProfileDataSource,
 		NewTranscodingProfilesDataSource,
+		NewCategoriesDataSource,
+		NewTenantDataSource,
 	}
 }
 
@@ -181,8 +356,17 @@ func (p *bpkioProvider) DataSources(_ context.Context) []func() datasource.DataS
 func (p *bpkioProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewServiceAdInsertionResource,
+		NewServiceContentReplacementResource,
+		NewServiceVirtualChannelResource,
+		NewVirtualChannelSlotResource,
+		NewVirtualChannelScheduleResource,
+		NewContentReplacementSlotResource,
+		NewCategoryResource,
+		NewTranscodingProfileResource,
 		NewSourceSlateResource,
 		NewSourceLiveResource,
 		NewSourceAdServerResource,
+		NewSourceAssetResource,
+		NewSourceAssetCatalogResource,
 	}
 }
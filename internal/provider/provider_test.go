@@ -4,15 +4,29 @@
 package provider
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccFakeAPI is the in-memory Broadpeak API the acceptance tests run
+// against when BPKIO_API_KEY is not set. It is nil when testing for real.
+var (
+	testAccFakeAPI     *fakeBroadpeakAPI
+	testAccFakeAPIOnce sync.Once
 )
 
 func testAccProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	testAccStartFakeAPI()
+
 	return map[string]func() (tfprotov6.ProviderServer, error){
 		"bpkio": func() (tfprotov6.ProviderServer, error) {
 			p := New("dev")()
@@ -22,12 +36,65 @@ func testAccProviderFactories() map[string]func() (tfprotov6.ProviderServer, err
 	}
 }
 
-func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("BPKIO_API_KEY"); v == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
+// testAccStartFakeAPI starts the fake API once per test binary, unless real
+// credentials are provided, and points the provider at it through
+// BPKIO_ENDPOINT.
+func testAccStartFakeAPI() {
+	if os.Getenv("BPKIO_API_KEY") != "" {
+		return
+	}
+
+	testAccFakeAPIOnce.Do(func() {
+		testAccFakeAPI = newFakeBroadpeakAPI(fakeAPIKey)
+		os.Setenv("BPKIO_ENDPOINT", testAccFakeAPI.URL)
+	})
+}
+
+// testAccAPIKey returns the API key the acceptance test configurations use.
+func testAccAPIKey() string {
+	if v := os.Getenv("BPKIO_API_KEY"); v != "" {
+		return v
+	}
+	return fakeAPIKey
+}
+
+// testAccRequireFakeAPI skips tests that need to tamper with server-side state.
+func testAccRequireFakeAPI(t *testing.T) {
+	t.Helper()
+	testAccStartFakeAPI()
+	if testAccFakeAPI == nil {
+		t.Skip("drift tests only run against the fake Broadpeak API")
+	}
+}
+
+// testAccCaptureID stores the numeric id of a resource, so that a later step
+// can tamper with the matching object on the fake API.
+func testAccCaptureID(resourceName string, id *uint) resource.TestCheckFunc {
+	return testAccCaptureAttrID(resourceName, "id", id)
+}
+
+// testAccCaptureAttrID stores the numeric id held by an attribute of a
+// resource, such as the id of a nested object.
+func testAccCaptureAttrID(resourceName, attr string, id *uint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		v, err := strconv.ParseUint(rs.Primary.Attributes[attr], 10, 64)
+		if err != nil {
+			return fmt.Errorf("resource %s has no numeric %s: %w", resourceName, attr, err)
+		}
+		*id = uint(v)
+		return nil
 	}
 }
 
+func testAccPreCheck(t *testing.T) {
+	t.Helper()
+	testAccStartFakeAPI()
+}
+
 /* ------------------------------------------------------------------------- */
 /* Acceptance test                                                            */
 /* ------------------------------------------------------------------------- */
@@ -47,3 +114,204 @@ provider "bpkio" {}
 		},
 	})
 }
+
+func TestAccProvider_invalidEndpoint(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key  = "%s"
+  endpoint = "ftp://api.broadpeak.io"
+}
+
+data "bpkio_sources" "all" {}
+`, testAccAPIKey()),
+				ExpectError: regexp.MustCompile(`Invalid bpkio API Endpoint`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidAPIKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "bpkio" {
+  api_key = "not-a-valid-key"
+}
+
+data "bpkio_tenant" "current" {}
+`,
+				ExpectError: regexp.MustCompile(`Unable to Validate bpkio API Key`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_skipCredentialsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				// The key is only rejected once a data source calls the API.
+				Config: `
+provider "bpkio" {
+  api_key                     = "not-a-valid-key"
+  skip_credentials_validation = true
+}
+
+data "bpkio_tenant" "current" {}
+`,
+				ExpectError: regexp.MustCompile(`Unable to Read Tenant`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_retriesRateLimitedRequests(t *testing.T) {
+	testAccRequireFakeAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccFakeAPI.Throttle(3) },
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key        = "%s"
+  max_retries    = 5
+  retry_wait_min = "1ms"
+  retry_wait_max = "10ms"
+}
+
+data "bpkio_tenant" "current" {}
+`, testAccAPIKey()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bpkio_tenant.current", "id", "1"),
+					func(*terraform.State) error {
+						if n := testAccFakeAPI.PendingThrottle(); n != 0 {
+							return fmt.Errorf("expected every throttled request to be retried, %d left", n)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_invalidRetryWait(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key        = "%s"
+  retry_wait_min = "1m"
+  retry_wait_max = "10s"
+}
+
+data "bpkio_tenant" "current" {}
+`, testAccAPIKey()),
+				ExpectError: regexp.MustCompile(`Invalid Retry Wait`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_unknownRetrySettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "terraform_data" "retries" {
+  input = 3
+}
+
+resource "terraform_data" "wait" {
+  input = "2s"
+}
+
+provider "bpkio" {
+  api_key        = "%s"
+  max_retries    = terraform_data.retries.output
+  retry_wait_min = terraform_data.wait.output
+  retry_wait_max = terraform_data.wait.output
+}
+
+data "bpkio_tenant" "current" {}
+`, testAccAPIKey()),
+				ExpectError: regexp.MustCompile(`Unknown bpkio Maximum Retries[\s\S]*Unknown bpkio Minimum Retry\s+Wait[\s\S]*Unknown bpkio Maximum Retry\s+Wait`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_requestLimits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key                 = "%s"
+  max_concurrent_requests = 1
+  requests_per_second     = 20
+}
+
+data "bpkio_tenant" "current" {}
+data "bpkio_sources" "all" {}
+data "bpkio_services" "all" {}
+`, testAccAPIKey()),
+				Check: resource.TestCheckResourceAttrSet("data.bpkio_tenant.current", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_unknownRequestLimits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		PreCheck:                 func() { testAccPreCheck(t) },
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "terraform_data" "limits" {
+  input = 2
+}
+
+provider "bpkio" {
+  api_key                 = "%s"
+  max_concurrent_requests = terraform_data.limits.output
+  requests_per_second     = terraform_data.limits.output
+  list_page_size          = terraform_data.limits.output
+}
+
+data "bpkio_tenant" "current" {}
+`, testAccAPIKey()),
+				ExpectError: regexp.MustCompile(`Unknown bpkio Maximum Concurrent Requests[\s\S]*Unknown bpkio Requests\s+Per\s+Second[\s\S]*Unknown bpkio List Page\s+Size`),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultMaxConcurrentRequests matches the default parallelism of Terraform.
+const defaultMaxConcurrentRequests = 10
+
+// limitTransport bounds the number of requests in flight and, optionally,
+// the rate at which they are sent. It is shared by every resource and data
+// source of a provider instance.
+type limitTransport struct {
+	next  http.RoundTripper
+	slots chan struct{}
+	// pacer is nil when the request rate is not capped.
+	pacer *pacer
+}
+
+// newLimitTransport returns a transport that allows maxConcurrent requests in
+// flight, or defaultMaxConcurrentRequests when it is not positive, and sends
+// at most requestsPerSecond requests per second when it is positive.
+func newLimitTransport(next http.RoundTripper, maxConcurrent int, requestsPerSecond float64) *limitTransport {
+	if maxConcurrent < 1 {
+		maxConcurrent = defaultMaxConcurrentRequests
+	}
+	t := &limitTransport{
+		next:  next,
+		slots: make(chan struct{}, maxConcurrent),
+	}
+	if requestsPerSecond > 0 {
+		t.pacer = &pacer{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper. The slot taken by a request is held
+// until its response body is closed.
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	select {
+	case t.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if t.pacer != nil {
+		if err := t.pacer.wait(ctx); err != nil {
+			<-t.slots
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+		return nil, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-t.slots }}
+	return resp, nil
+}
+
+// releasingBody calls release once, when the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// pacer spaces requests at least interval apart.
+type pacer struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the caller may send its request, or ctx is done.
+func (p *pacer) wait(ctx context.Context) error {
+	p.mu.Lock()
+	now := time.Now()
+	if p.next.Before(now) {
+		p.next = now
+	}
+	at := p.next
+	p.next = p.next.Add(p.interval)
+	p.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+	tflog.Trace(ctx, "Delaying bpkio API request to honor requests_per_second", map[string]interface{}{"delay": delay.String()})
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
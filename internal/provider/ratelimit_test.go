@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitTransport_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret", withConcurrencyLimit(2))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetSlate(context.Background(), 7)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 2, peak.Load())
+}
+
+func TestLimitTransport_RequestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret", withRequestsPerSecond(50))
+	require.NoError(t, err)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := client.GetSlate(context.Background(), 7)
+		require.NoError(t, err)
+	}
+	// The first request goes out at once, the next four 20ms apart.
+	require.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
+
+func TestLimitTransport_ContextCancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte(`{"id":7}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := newBpkioClient(server.URL, "secret", withConcurrencyLimit(1))
+	require.NoError(t, err)
+
+	go func() { _, _ = client.GetSlate(context.Background(), 7) }()
+	time.Sleep(20 * time.Millisecond)
+
+	// The only slot is taken, so this request must give up with its context.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.GetSlate(ctx, 7)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLimitTransport_DefaultsMaxConcurrentRequests(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		transport := newLimitTransport(http.DefaultTransport, n, 0)
+		require.Equal(t, defaultMaxConcurrentRequests, cap(transport.slots))
+	}
+}
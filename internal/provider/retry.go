@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryPolicy controls how failed API requests are retried.
+type retryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// WaitMin and WaitMax bound the exponential backoff between attempts.
+	WaitMin time.Duration
+	WaitMax time.Duration
+}
+
+// defaultRetryPolicy is used when the provider configuration does not
+// override the retry settings.
+var defaultRetryPolicy = retryPolicy{
+	MaxRetries: 4,
+	WaitMin:    time.Second,
+	WaitMax:    30 * time.Second,
+}
+
+// retryTransport retries rate-limited requests, and idempotent requests that
+// failed with a server or network error.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy retryPolicy
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if attempt >= t.policy.MaxRetries || ctx.Err() != nil || !shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := t.policy.backoff(attempt, resp)
+		fields := map[string]interface{}{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"attempt":     attempt + 1,
+			"max_retries": t.policy.MaxRetries,
+			"wait":        wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Drain the body so the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying bpkio API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a request that returned resp or err may be sent
+// again. Rate-limited requests were not processed and are always retried;
+// server and network errors are only retried for idempotent methods, as the
+// API may have applied the request before failing.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	idempotent := method == http.MethodGet || method == http.MethodHead ||
+		method == http.MethodPut || method == http.MethodDelete
+
+	if err != nil {
+		return idempotent
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented {
+		return idempotent
+	}
+	return false
+}
+
+// backoff returns how long to wait before the retry that follows attempt. A
+// Retry-After header sent by the API takes precedence; otherwise the wait
+// doubles from WaitMin up to WaitMax, with jitter so that parallel operations
+// do not retry in lockstep.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return wait
+		}
+	}
+
+	wait := p.WaitMax
+	if attempt < 32 && p.WaitMin <= p.WaitMax>>attempt {
+		wait = p.WaitMin << attempt
+	}
+
+	// Pick a wait in [wait/2, wait], but never below WaitMin.
+	half := wait / 2
+	wait = half + rand.N(half+1)
+	return max(wait, p.WaitMin)
+}
+
+// parseRetryAfter decodes a Retry-After header, given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = retryPolicy{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 5 * time.Millisecond}
+
+// testRetryServer answers with the given statuses in turn, then with 200.
+func testRetryServer(t *testing.T, statuses ...int) (*bpkioClient, *atomic.Int32, *[]string) {
+	t.Helper()
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			_, _ = w.Write([]byte(`{"message":"try again"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":7,"name":"slate"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := newBpkioClient(server.URL, "secret", withRetryPolicy(testRetryPolicy))
+	require.NoError(t, err)
+	return client, &calls, &bodies
+}
+
+func TestRetryTransport_RateLimitedPost(t *testing.T) {
+	client, calls, bodies := testRetryServer(t, http.StatusTooManyRequests, http.StatusTooManyRequests)
+
+	slate, err := client.CreateSlate(context.Background(), broadpeakio.SlateInput{Name: "slate", Url: "https://example.com/slate.jpg"})
+	require.NoError(t, err)
+	require.Equal(t, uint(7), slate.Id)
+	require.EqualValues(t, 3, calls.Load())
+	// The request body must be sent again on every attempt.
+	require.Len(t, *bodies, 3)
+	require.Equal(t, (*bodies)[0], (*bodies)[2])
+	require.Contains(t, (*bodies)[2], `"name":"slate"`)
+}
+
+func TestRetryTransport_ServerErrorIdempotentOnly(t *testing.T) {
+	client, calls, _ := testRetryServer(t, http.StatusBadGateway)
+	_, err := client.GetSlate(context.Background(), 7)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, calls.Load())
+
+	client, calls, _ = testRetryServer(t, http.StatusServiceUnavailable)
+	_, err = client.CreateSlate(context.Background(), broadpeakio.SlateInput{Name: "slate"})
+	require.ErrorContains(t, err, "503")
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestRetryTransport_NotRetried(t *testing.T) {
+	client, calls, _ := testRetryServer(t, http.StatusBadRequest)
+	_, err := client.GetSlate(context.Background(), 7)
+	require.ErrorContains(t, err, "400")
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	client, calls, _ := testRetryServer(t, 429, 429, 429, 429, 429)
+	_, err := client.GetSlate(context.Background(), 7)
+	require.ErrorContains(t, err, "429")
+	require.EqualValues(t, testRetryPolicy.MaxRetries+1, calls.Load())
+}
+
+func TestRetryTransport_ContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client, err := newBpkioClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = client.GetSlate(ctx, 7)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := retryPolicy{MaxRetries: 10, WaitMin: time.Second, WaitMax: 8 * time.Second}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := policy.backoff(attempt, nil)
+		require.GreaterOrEqual(t, wait, policy.WaitMin)
+		require.LessOrEqual(t, wait, policy.WaitMax)
+	}
+	require.GreaterOrEqual(t, policy.backoff(3, nil), 4*time.Second)
+	require.GreaterOrEqual(t, policy.backoff(40, nil), 4*time.Second)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"12"}}}
+	require.Equal(t, 12*time.Second, policy.backoff(0, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value  string
+		expect time.Duration
+		ok     bool
+	}{
+		{value: "", ok: false},
+		{value: "5", expect: 5 * time.Second, ok: true},
+		{value: "-1", ok: false},
+		{value: "Mon, 01 Jan 2024 12:00:30 GMT", expect: 30 * time.Second, ok: true},
+		{value: "Mon, 01 Jan 2024 11:00:00 GMT", expect: 0, ok: true},
+		{value: "soon", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expect, got)
+		})
+	}
+}
@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	broadpeakio "github.com/bashou/bpkio-go-sdk"
-
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,7 +21,7 @@ var (
 
 // serviceAdInsertionDataSource is the data source implementation.
 type serviceAdInsertionDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewServiceAdInsertionDataSource is a helper function to simplify the provider implementation.
@@ -39,11 +37,11 @@ func (d *serviceAdInsertionDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -61,14 +59,8 @@ func (d *serviceAdInsertionDataSource) Metadata(_ context.Context, req datasourc
 func (d *serviceAdInsertionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Required:    true,
-				Description: "The ID of the service.",
-			},
-			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "The name of the service.",
-			},
+			"id":   lookupIDAttribute("ad insertion service"),
+			"name": lookupNameAttribute("ad insertion service"),
 			"type": schema.StringAttribute{
 				Computed:    true,
 				Description: "The type of the service.",
@@ -152,6 +144,89 @@ func (d *serviceAdInsertionDataSource) Schema(_ context.Context, _ datasource.Sc
 				Computed:    true,
 				Description: "Configuration of live pre-roll",
 			},
+			"vod_ad_insertion": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"ad_server": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{
+								Computed:    true,
+								Description: "The ID of the ad server source.",
+							},
+							"name": schema.StringAttribute{
+								Computed:    true,
+								Description: "The name of the ad server source.",
+							},
+							"type": schema.StringAttribute{
+								Computed:    true,
+								Description: "The type of the ad server source.",
+							},
+							"url": schema.StringAttribute{
+								Computed:    true,
+								Description: "The URL of the ad server source.",
+							},
+							"query_parameters": schema.ListNestedAttribute{
+								Computed:    true,
+								Description: "The query parameters passed to the ad server requests.",
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"type": schema.StringAttribute{
+											Computed:    true,
+											Description: "The type of the query parameter (values: `custom`, `forward`, `from-query-parameter`, `from-variable`, `from-header`).",
+										},
+										"name": schema.StringAttribute{
+											Computed:    true,
+											Description: "The name of the query parameter.",
+										},
+										"value": schema.StringAttribute{
+											Computed:    true,
+											Description: "The value of the query parameter.",
+										},
+									},
+								},
+							},
+						},
+						Computed:    true,
+						Description: "Configuration of ad server",
+					},
+					"pre_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"max_duration": schema.Int64Attribute{
+								Computed:    true,
+								Description: "Pre-roll maximum duration (in seconds)",
+							},
+						},
+						Computed:    true,
+						Description: "Pre-roll break, inserted before the content",
+					},
+					"mid_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"positions": schema.ListAttribute{
+								Computed:    true,
+								ElementType: types.Int64Type,
+								Description: "Positions of the mid-roll breaks (in seconds from the start of the content)",
+							},
+							"max_duration": schema.Int64Attribute{
+								Computed:    true,
+								Description: "Maximum duration of each mid-roll break (in seconds)",
+							},
+						},
+						Computed:    true,
+						Description: "Mid-roll breaks, inserted at fixed positions in the content",
+					},
+					"post_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"max_duration": schema.Int64Attribute{
+								Computed:    true,
+								Description: "Post-roll maximum duration (in seconds)",
+							},
+						},
+						Computed:    true,
+						Description: "Post-roll break, inserted after the content",
+					},
+				},
+				Computed:    true,
+				Description: "Configuration of VOD ad insertion",
+			},
 			"live_ad_replacement": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"ad_server": schema.SingleNestedAttribute{
@@ -335,7 +410,8 @@ func (d *serviceAdInsertionDataSource) Schema(_ context.Context, _ datasource.Sc
 						Computed: true,
 					},
 					"content": schema.StringAttribute{
-						Computed: true,
+						CustomType: jsonStringType{},
+						Computed:   true,
 					},
 				},
 				Optional:    true,
@@ -348,11 +424,16 @@ func (d *serviceAdInsertionDataSource) Schema(_ context.Context, _ datasource.Sc
 // Read refreshes the Terraform state with the latest data.
 func (d *serviceAdInsertionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state serviceAdInsertionDataSourceModel
-	var serviceid int64
+	var id types.Int64
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags := req.Config.GetAttribute(ctx, path.Root("id"), &serviceid)
+	serviceid, diags := lookupID(ctx, id, name, "ad insertion service", d.client.namedServices("ad-insertion"))
 	resp.Diagnostics.Append(diags...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -361,7 +442,7 @@ func (d *serviceAdInsertionDataSource) Read(ctx context.Context, req datasource.
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
 	// Get the service from the API
-	service, err := d.client.GetAdInsertion(uint(serviceid))
+	service, err := d.client.GetAdInsertion(ctx, uint(serviceid))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Single Service",
@@ -415,7 +496,7 @@ func (d *serviceAdInsertionDataSource) Read(ctx context.Context, req datasource.
 			ID:         types.Int64Value(int64(service.TranscodingProfile.Id)),
 			Name:       types.StringValue(service.TranscodingProfile.Name),
 			InternalId: types.StringValue(service.TranscodingProfile.InternalId),
-			Content:    types.StringValue(service.TranscodingProfile.Content),
+			Content:    newJSONString(service.TranscodingProfile.Content),
 		},
 		AdvancedOptions: &advancedOptionsModel{
 			AuthorizationHeader: &authorizationHeaderModel{
@@ -480,6 +561,8 @@ func (d *serviceAdInsertionDataSource) Read(ctx context.Context, req datasource.
 		}
 	}
 
+	serviceState.VodAdInsertion = flattenVodAdInsertion(service.VodAdInsertion)
+
 	// Set state
 	diags = resp.State.Set(ctx, &serviceState)
 	resp.Diagnostics.Append(diags...)
@@ -488,7 +571,7 @@ func (d *serviceAdInsertionDataSource) Read(ctx context.Context, req datasource.
 	}
 }
 
-func flattenAdInsertionOutput(s broadpeakio.AdInsertionOutput, ctx context.Context) (*serviceAdInsertionDataSourceModel, error) {
+func flattenAdInsertionOutput(s adInsertionOutput, ctx context.Context) (*serviceAdInsertionDataSourceModel, error) {
 	// Tags
 	tagsList, diags := types.ListValueFrom(ctx, types.StringType, s.Tags)
 	if diags.HasError() {
@@ -539,7 +622,7 @@ func flattenAdInsertionOutput(s broadpeakio.AdInsertionOutput, ctx context.Conte
 		ID:         types.Int64Value(int64(s.TranscodingProfile.Id)),
 		Name:       types.StringValue(s.TranscodingProfile.Name),
 		InternalId: types.StringValue(s.TranscodingProfile.InternalId),
-		Content:    types.StringValue(s.TranscodingProfile.Content),
+		Content:    newJSONString(s.TranscodingProfile.Content),
 	}
 
 	// LiveAdPreroll
@@ -613,9 +696,62 @@ func flattenAdInsertionOutput(s broadpeakio.AdInsertionOutput, ctx context.Conte
 		AdvancedOptions:      advancedOptions,
 		LiveAdPreRoll:        liveAdPreroll,
 		LiveAdReplacement:    liveAdReplacement,
+		VodAdInsertion:       flattenVodAdInsertion(s.VodAdInsertion),
 	}, nil
 }
 
+// flattenVodAdInsertion maps the vodAdInsertion response field, or returns
+// nil when the service has no VOD ad insertion.
+func flattenVodAdInsertion(v vodAdInsertionOutput) *vodAdInsertionModel {
+	if v.AdServer.Id == 0 {
+		return nil
+	}
+	var params []queryParametersModel
+	for _, p := range v.AdServer.QueryParameters {
+		params = append(params, queryParametersModel{
+			Type:  types.StringValue(p.Type),
+			Name:  types.StringValue(p.Name),
+			Value: types.StringValue(p.Value),
+		})
+	}
+	return &vodAdInsertionModel{
+		AdServer: adServerModel{
+			ID:              types.Int64Value(int64(v.AdServer.Id)),
+			Name:            types.StringValue(v.AdServer.Name),
+			Type:            types.StringValue(v.AdServer.Type),
+			URL:             types.StringValue(v.AdServer.Url),
+			QueryParameters: params,
+		},
+		PreRoll:  flattenVodAdBreak(v.PreRoll),
+		MidRoll:  flattenVodMidRoll(v.MidRoll),
+		PostRoll: flattenVodAdBreak(v.PostRoll),
+	}
+}
+
+// flattenVodAdBreak maps a pre-roll or post-roll break. A zero maximum
+// duration means that none was configured.
+func flattenVodAdBreak(b *vodAdBreak) *vodAdBreakModel {
+	if b == nil {
+		return nil
+	}
+	return &vodAdBreakModel{MaxDuration: toInt64OrNull(b.MaxDuration)}
+}
+
+// flattenVodMidRoll maps the mid-roll breaks.
+func flattenVodMidRoll(m *vodMidRoll) *vodMidRollModel {
+	if m == nil {
+		return nil
+	}
+	out := &vodMidRollModel{
+		Positions:   []types.Int64{},
+		MaxDuration: toInt64OrNull(m.MaxDuration),
+	}
+	for _, p := range m.Positions {
+		out.Positions = append(out.Positions, types.Int64Value(int64(p)))
+	}
+	return out
+}
+
 // serviceModel maps service schema data.
 type serviceAdInsertionDataSourceModel struct {
 	ID                   types.Int64                        `tfsdk:"id"`
@@ -629,6 +765,7 @@ type serviceAdInsertionDataSourceModel struct {
 	AdvancedOptions      *advancedOptionsModel              `tfsdk:"advanced_options"`
 	LiveAdPreRoll        *liveAdPrerollModel                `tfsdk:"live_ad_preroll"`
 	LiveAdReplacement    *liveAdReplacementModel            `tfsdk:"live_ad_replacement"`
+	VodAdInsertion       *vodAdInsertionModel               `tfsdk:"vod_ad_insertion"`
 	EnableAdTranscoding  types.Bool                         `tfsdk:"enable_ad_transcoding"`
 	ServerSideAdTracking *serverSideAdTrackingModel         `tfsdk:"server_side_ad_tracking"`
 	Source               *sourceModel                       `tfsdk:"source"`
@@ -681,6 +818,22 @@ type liveAdReplacementModel struct {
 	SpotAware spotAwareModel `tfsdk:"spot_aware"`
 }
 
+type vodAdInsertionModel struct {
+	AdServer adServerModel    `tfsdk:"ad_server"`
+	PreRoll  *vodAdBreakModel `tfsdk:"pre_roll"`
+	MidRoll  *vodMidRollModel `tfsdk:"mid_roll"`
+	PostRoll *vodAdBreakModel `tfsdk:"post_roll"`
+}
+
+type vodAdBreakModel struct {
+	MaxDuration types.Int64 `tfsdk:"max_duration"`
+}
+
+type vodMidRollModel struct {
+	Positions   []types.Int64 `tfsdk:"positions"`
+	MaxDuration types.Int64   `tfsdk:"max_duration"`
+}
+
 type spotAwareModel struct {
 	Mode types.String `tfsdk:"mode"`
 }
@@ -83,7 +83,7 @@ func TestFlattenAdInsertionOutput_basic(t *testing.T) {
 		},
 	}
 
-	out, err := flattenAdInsertionOutput(in, ctx)
+	out, err := flattenAdInsertionOutput(adInsertionOutput{AdInsertionOutput: in}, ctx)
 	require.NoError(t, err)
 	require.Equal(t, types.Int64Value(1), out.ID)
 	require.Equal(t, types.StringValue("AI Service"), out.Name)
@@ -101,4 +101,35 @@ func TestFlattenAdInsertionOutput_basic(t *testing.T) {
 	require.Equal(t, types.StringValue("gapfiller"), out.LiveAdReplacement.GapFiller.Name)
 	require.Equal(t, types.StringValue("spot_to_live"), out.LiveAdReplacement.SpotAware.Mode)
 	require.Len(t, out.Tags.Elements(), 2)
+	require.Nil(t, out.VodAdInsertion)
+}
+
+func TestFlattenAdInsertionOutput_vod(t *testing.T) {
+	in := adInsertionOutput{
+		AdInsertionOutput: broadpeakio.AdInsertionOutput{
+			Id:   1,
+			Name: "VOD Service",
+			Type: "ad-insertion",
+		},
+		VodAdInsertion: vodAdInsertionOutput{
+			AdServer: broadpeakio.AdServer{
+				Id:   4,
+				Name: "adserver",
+				Type: "ad-server",
+				Url:  "http://adserver",
+			},
+			PreRoll: &vodAdBreak{MaxDuration: 30},
+			MidRoll: &vodMidRoll{Positions: []uint{300, 600}},
+		},
+	}
+
+	out, err := flattenAdInsertionOutput(in, context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, out.VodAdInsertion)
+	require.Equal(t, types.Int64Value(4), out.VodAdInsertion.AdServer.ID)
+	require.Equal(t, types.StringValue("adserver"), out.VodAdInsertion.AdServer.Name)
+	require.Equal(t, types.Int64Value(30), out.VodAdInsertion.PreRoll.MaxDuration)
+	require.Equal(t, []types.Int64{types.Int64Value(300), types.Int64Value(600)}, out.VodAdInsertion.MidRoll.Positions)
+	require.True(t, out.VodAdInsertion.MidRoll.MaxDuration.IsNull())
+	require.Nil(t, out.VodAdInsertion.PostRoll)
 }
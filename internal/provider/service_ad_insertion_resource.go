@@ -10,6 +10,9 @@ import (
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -42,7 +45,40 @@ func NewServiceAdInsertionResource() resource.Resource {
 
 // serviceAdInsertionResource is the resource implementation.
 type serviceAdInsertionResource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
+}
+
+// serviceAdInsertionAPIFields maps the request fields the API may reject to
+// the attributes they are read from.
+var serviceAdInsertionAPIFields = map[string]path.Path{
+	"name":                                                 path.Root("name"),
+	"tags":                                                 path.Root("tags"),
+	"source":                                               path.Root("source"),
+	"source.id":                                            path.Root("source").AtName("id"),
+	"transcodingProfile":                                   path.Root("transcoding_profile"),
+	"transcodingProfile.id":                                path.Root("transcoding_profile").AtName("id"),
+	"liveAdReplacement":                                    path.Root("live_ad_replacement"),
+	"liveAdReplacement.adServer":                           path.Root("live_ad_replacement").AtName("ad_server"),
+	"liveAdReplacement.adServer.id":                        path.Root("live_ad_replacement").AtName("ad_server").AtName("id"),
+	"liveAdReplacement.gapFiller":                          path.Root("live_ad_replacement").AtName("gap_filler"),
+	"liveAdReplacement.gapFiller.id":                       path.Root("live_ad_replacement").AtName("gap_filler").AtName("id"),
+	"liveAdReplacement.spotAware.mode":                     path.Root("live_ad_replacement").AtName("spot_aware").AtName("mode"),
+	"liveAdPreRoll":                                        path.Root("live_ad_preroll"),
+	"liveAdPreRoll.adServer":                               path.Root("live_ad_preroll").AtName("ad_server"),
+	"liveAdPreRoll.adServer.id":                            path.Root("live_ad_preroll").AtName("ad_server").AtName("id"),
+	"liveAdPreRoll.maxDuration":                            path.Root("live_ad_preroll").AtName("max_duration"),
+	"liveAdPreRoll.offset":                                 path.Root("live_ad_preroll").AtName("offset"),
+	"vodAdInsertion":                                       path.Root("vod_ad_insertion"),
+	"vodAdInsertion.adServer":                              path.Root("vod_ad_insertion").AtName("ad_server"),
+	"vodAdInsertion.adServer.id":                           path.Root("vod_ad_insertion").AtName("ad_server").AtName("id"),
+	"vodAdInsertion.preRoll.maxDuration":                   path.Root("vod_ad_insertion").AtName("pre_roll").AtName("max_duration"),
+	"vodAdInsertion.midRoll.positions":                     path.Root("vod_ad_insertion").AtName("mid_roll").AtName("positions"),
+	"vodAdInsertion.midRoll.maxDuration":                   path.Root("vod_ad_insertion").AtName("mid_roll").AtName("max_duration"),
+	"vodAdInsertion.postRoll.maxDuration":                  path.Root("vod_ad_insertion").AtName("post_roll").AtName("max_duration"),
+	"advancedOptions.authorizationHeader":                  path.Root("advanced_options").AtName("authorization_header"),
+	"enableAdTranscoding":                                  path.Root("enable_ad_transcoding"),
+	"serverSideAdTracking.enable":                          path.Root("server_side_ad_tracking").AtName("enable"),
+	"serverSideAdTracking.checkAdMediaSegmentAvailability": path.Root("server_side_ad_tracking").AtName("check_ad_media_segment_availability"),
 }
 
 // Configure adds the provider configured client to the resource.
@@ -52,11 +88,11 @@ func (r *serviceAdInsertionResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -71,9 +107,9 @@ func (r *serviceAdInsertionResource) Metadata(_ context.Context, req resource.Me
 }
 
 // Schema defines the schema for the resource.
-func (r *serviceAdInsertionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *serviceAdInsertionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages Ad Insertion service creation (see https://developers.broadpeak.io/reference/adinsertiontroller_create_v1).",
+		Description: "Manages Ad Insertion service creation (see https://developers.broadpeak.io/reference/adinsertioncontroller_create_v1).",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed:    true,
@@ -266,6 +302,86 @@ func (r *serviceAdInsertionResource) Schema(_ context.Context, _ resource.Schema
 				},
 				Optional: true,
 			},
+			"vod_ad_insertion": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					// The ad server can change in place, so its computed
+					// attributes are not carried over from state; they would
+					// keep describing the previous ad server.
+					"ad_server": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{
+								Required:    true,
+								Description: "ID of the ad server. This is a unique identifier for the ad server.",
+							},
+							"name": schema.StringAttribute{
+								Computed:    true,
+								Description: "Name of the ad server. This is a human-readable name for the ad server.",
+							},
+							"type": schema.StringAttribute{
+								Computed:    true,
+								Description: "Type of the ad server. This indicates the type of ad server being used.",
+							},
+							"url": schema.StringAttribute{
+								Computed:    true,
+								Description: "URL of the ad server. This is the endpoint where the ad server can be accessed.",
+							},
+						},
+						Required:    true,
+						Description: "Ad server configuration. This is the ad server queried for the VOD ad breaks.",
+					},
+					"pre_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"max_duration": schema.Int64Attribute{
+								Optional:    true,
+								Description: "Pre-roll maximum duration (in seconds).",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+						Optional:    true,
+						Description: "Pre-roll break, inserted before the content.",
+					},
+					"mid_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"positions": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.Int64Type,
+								Description: "Positions of the mid-roll breaks (in seconds from the start of the content).",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+								},
+							},
+							"max_duration": schema.Int64Attribute{
+								Optional:    true,
+								Description: "Maximum duration of each mid-roll break (in seconds).",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+						Optional:    true,
+						Description: "Mid-roll breaks, inserted at fixed positions in the content.",
+					},
+					"post_roll": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"max_duration": schema.Int64Attribute{
+								Optional:    true,
+								Description: "Post-roll maximum duration (in seconds).",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+						Optional:    true,
+						Description: "Post-roll break, inserted after the content.",
+					},
+				},
+				Optional:    true,
+				Description: "VOD ad insertion configuration. This is the configuration for ad insertion in asset and asset catalog sources.",
+			},
 			"advanced_options": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"authorization_header": schema.SingleNestedAttribute{
@@ -388,18 +504,22 @@ func (r *serviceAdInsertionResource) Schema(_ context.Context, _ resource.Schema
 						},
 					},
 					"content": schema.StringAttribute{
-						Optional: true,
-						Computed: true,
+						CustomType: jsonStringType{},
+						Optional:   true,
+						Computed:   true,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							jsonSemanticEquality{},
 						},
 					},
 				},
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
-
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -418,6 +538,12 @@ func (r *serviceAdInsertionResource) Create(
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//--------------------------------------------------------------------
 	// 2. Convert plan -> API input
 	//--------------------------------------------------------------------
@@ -431,9 +557,12 @@ func (r *serviceAdInsertionResource) Create(
 		}
 	}
 
-	input := broadpeakio.CreateAdInsertionInput{
-		Name: plan.Name.ValueString(),
-		Tags: tags,
+	input := createAdInsertionInput{
+		CreateAdInsertionInput: broadpeakio.CreateAdInsertionInput{
+			Name: plan.Name.ValueString(),
+			Tags: tags,
+		},
+		VodAdInsertion: expandVodAdInsertion(plan.VodAdInsertion),
 	}
 
 	// Optional fields
@@ -505,9 +634,9 @@ func (r *serviceAdInsertionResource) Create(
 	//--------------------------------------------------------------------
 	// 3. Call Broadpeak API
 	//--------------------------------------------------------------------
-	service, err := r.client.CreateAdInsertion(input)
+	service, err := r.client.CreateAdInsertion(ctx, input)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating Ad-Insertion", err.Error())
+		addAPIError(&resp.Diagnostics, "Error creating Ad-Insertion", "Could not create ad insertion service", err, serviceAdInsertionAPIFields)
 		return
 	}
 
@@ -530,6 +659,7 @@ func (r *serviceAdInsertionResource) Create(
 		State:               types.StringValue(service.State),
 		Tags:                tagsList,
 		EnableAdTranscoding: types.BoolValue(service.EnableAdTranscoding),
+		Timeouts:            plan.Timeouts,
 	}
 
 	// Server-side ad-tracking
@@ -546,7 +676,7 @@ func (r *serviceAdInsertionResource) Create(
 			ID:         types.Int64Value(int64(service.TranscodingProfile.Id)),
 			Name:       types.StringValue(service.TranscodingProfile.Name),
 			InternalId: types.StringValue(service.TranscodingProfile.InternalId),
-			Content:    types.StringValue(service.TranscodingProfile.Content),
+			Content:    newJSONString(service.TranscodingProfile.Content),
 		}
 	}
 
@@ -603,6 +733,9 @@ func (r *serviceAdInsertionResource) Create(
 		}
 	}
 
+	// VodAdInsertion
+	state.VodAdInsertion = flattenVodAdInsertionLite(service.VodAdInsertion)
+
 	// Advanced options
 	if service.AdvancedOptions.AuthorizationHeader.Name != "" || service.AdvancedOptions.AuthorizationHeader.Value != "" {
 		state.AdvancedOptions = &advancedOptionsModel{
@@ -627,11 +760,16 @@ func (r *serviceAdInsertionResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	service, err := r.client.GetAdInsertion(uint(state.ID.ValueInt64()))
+	service, err := r.client.GetAdInsertion(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Ad insertion service no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Single Service",
-			fmt.Sprintf("Service with ID %d not found (%s)", state.ID.ValueInt64(), err.Error()),
+			fmt.Sprintf("Could not read ad insertion service ID %d: %s", state.ID.ValueInt64(), err.Error()),
 		)
 		return
 	}
@@ -655,7 +793,9 @@ func (r *serviceAdInsertionResource) Read(ctx context.Context, req resource.Read
 		TranscodingProfile:   nil,
 		LiveAdReplacement:    nil,
 		LiveAdPreRoll:        nil,
+		VodAdInsertion:       flattenVodAdInsertionLite(service.VodAdInsertion),
 		AdvancedOptions:      nil,
+		Timeouts:             state.Timeouts,
 	}
 
 	// ServerSideAdTracking
@@ -694,14 +834,14 @@ func (r *serviceAdInsertionResource) Read(ctx context.Context, req resource.Read
 			ID:         types.Int64Value(int64(service.TranscodingProfile.Id)),
 			Name:       toStringOrEmpty(service.TranscodingProfile.Name),
 			InternalId: toStringOrEmpty(service.TranscodingProfile.InternalId),
-			Content:    toStringOrEmpty(service.TranscodingProfile.Content),
+			Content:    newJSONString(service.TranscodingProfile.Content),
 		}
 	} else {
 		state.TranscodingProfile = &transcodingProfileDataSourceModel{
 			ID:         types.Int64Null(),
 			Name:       types.StringValue(""),
 			InternalId: types.StringValue(""),
-			Content:    types.StringValue(""),
+			Content:    newJSONString(""),
 		}
 	}
 
@@ -772,6 +912,49 @@ func (r *serviceAdInsertionResource) Read(ctx context.Context, req resource.Read
 	resp.Diagnostics.Append(diags...)
 }
 
+// expandVodAdInsertion builds the vodAdInsertion request field, or nil when
+// VOD ad insertion is not configured.
+func expandVodAdInsertion(m *vodAdInsertionLiteModel) *vodAdInsertion {
+	if m == nil {
+		return nil
+	}
+	out := &vodAdInsertion{
+		AdServer: &broadpeakio.Identifiable{Id: uint(m.AdServer.ID.ValueInt64())},
+	}
+	if m.PreRoll != nil {
+		out.PreRoll = &vodAdBreak{MaxDuration: uint(m.PreRoll.MaxDuration.ValueInt64())}
+	}
+	if m.MidRoll != nil {
+		out.MidRoll = &vodMidRoll{MaxDuration: uint(m.MidRoll.MaxDuration.ValueInt64())}
+		for _, p := range m.MidRoll.Positions {
+			out.MidRoll.Positions = append(out.MidRoll.Positions, uint(p.ValueInt64()))
+		}
+	}
+	if m.PostRoll != nil {
+		out.PostRoll = &vodAdBreak{MaxDuration: uint(m.PostRoll.MaxDuration.ValueInt64())}
+	}
+	return out
+}
+
+// flattenVodAdInsertionLite maps the vodAdInsertion response field, or
+// returns nil when the service has no VOD ad insertion.
+func flattenVodAdInsertionLite(v vodAdInsertionOutput) *vodAdInsertionLiteModel {
+	if v.AdServer.Id == 0 {
+		return nil
+	}
+	return &vodAdInsertionLiteModel{
+		AdServer: adServerLiteModel{
+			ID:   types.Int64Value(int64(v.AdServer.Id)),
+			Name: types.StringValue(v.AdServer.Name),
+			Type: types.StringValue(v.AdServer.Type),
+			URL:  types.StringValue(v.AdServer.Url),
+		},
+		PreRoll:  flattenVodAdBreak(v.PreRoll),
+		MidRoll:  flattenVodMidRoll(v.MidRoll),
+		PostRoll: flattenVodAdBreak(v.PostRoll),
+	}
+}
+
 // Helper
 func toStringOrEmpty(s string) types.String {
 	if s == "" {
@@ -787,6 +970,12 @@ func toStringOrNull(val string) types.String {
 	}
 	return types.StringValue(val)
 }
+func toInt64OrNull(val uint) types.Int64 {
+	if val == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(val))
+}
 func toBoolOrNull(val bool) types.Bool {
 	// Only use Null if you have an "unknown" state, otherwise just use Value
 	return types.BoolValue(val)
@@ -803,6 +992,12 @@ func (r *serviceAdInsertionResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert from Terraform model to API model
 	var tags []string
 	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
@@ -813,9 +1008,12 @@ func (r *serviceAdInsertionResource) Update(ctx context.Context, req resource.Up
 		}
 	}
 
-	var serviceData = broadpeakio.UpdateAdInsertionInput{
-		Name: plan.Name.ValueString(),
-		Tags: tags,
+	var serviceData = updateAdInsertionInput{
+		UpdateAdInsertionInput: broadpeakio.UpdateAdInsertionInput{
+			Name: plan.Name.ValueString(),
+			Tags: tags,
+		},
+		VodAdInsertion: expandVodAdInsertion(plan.VodAdInsertion),
 	}
 
 	// Add TranscodingProfile if provided
@@ -901,17 +1099,14 @@ func (r *serviceAdInsertionResource) Update(ctx context.Context, req resource.Up
 	tflog.Debug(ctx, "Update - Update Doc sent to BPKIO", map[string]interface{}{"id": adinsertionID, "updates": serviceData})
 
 	// Update existing adserver
-	_, err := r.client.UpdateAdInsertion(adinsertionID, serviceData)
+	_, err := r.client.UpdateAdInsertion(ctx, adinsertionID, serviceData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating adserver",
-			"Could not update adserver, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error updating Ad-Insertion", fmt.Sprintf("Could not update ad insertion service ID %d", adinsertionID), err, serviceAdInsertionAPIFields)
 		return
 	}
 
 	// Fetch updated items from GetAdInsertion
-	service, err := r.client.GetAdInsertion(adinsertionID)
+	service, err := r.client.GetAdInsertion(ctx, adinsertionID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AdInsertion",
@@ -938,6 +1133,7 @@ func (r *serviceAdInsertionResource) Update(ctx context.Context, req resource.Up
 		State:               types.StringValue(service.State),
 		Tags:                tagsList,
 		EnableAdTranscoding: types.BoolValue(service.EnableAdTranscoding),
+		Timeouts:            plan.Timeouts,
 		Source: &sourceLiteModel{
 			ID:          types.Int64Value(int64(service.Source.Id)),
 			Name:        types.StringValue(service.Source.Name),
@@ -950,8 +1146,9 @@ func (r *serviceAdInsertionResource) Update(ctx context.Context, req resource.Up
 			ID:         types.Int64Value(int64(service.TranscodingProfile.Id)),
 			Name:       types.StringValue(service.TranscodingProfile.Name),
 			InternalId: types.StringValue(service.TranscodingProfile.InternalId),
-			Content:    types.StringValue(service.TranscodingProfile.Content),
+			Content:    newJSONString(service.TranscodingProfile.Content),
 		},
+		VodAdInsertion: flattenVodAdInsertionLite(service.VodAdInsertion),
 	}
 
 	if service.LiveAdPreRoll.AdServer.Id != 0 {
@@ -1026,9 +1223,16 @@ func (r *serviceAdInsertionResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing adserver
-	_, err := r.client.DeleteAdInsertion(uint(state.ID.ValueInt64()))
-	if err != nil {
+	_, err := r.client.DeleteAdInsertion(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Source AdServer",
 			"Could not delete adserver, unexpected error: "+err.Error(),
@@ -1071,10 +1275,12 @@ type serviceAdInsertionResourceModel struct {
 	AdvancedOptions      *advancedOptionsModel              `tfsdk:"advanced_options"`
 	LiveAdPreRoll        *liveAdPrerollLiteModel            `tfsdk:"live_ad_preroll"`
 	LiveAdReplacement    *liveAdReplacementLiteModel        `tfsdk:"live_ad_replacement"`
+	VodAdInsertion       *vodAdInsertionLiteModel           `tfsdk:"vod_ad_insertion"`
 	EnableAdTranscoding  types.Bool                         `tfsdk:"enable_ad_transcoding"`
 	ServerSideAdTracking *serverSideAdTrackingModel         `tfsdk:"server_side_ad_tracking"`
 	Source               *sourceLiteModel                   `tfsdk:"source"`
 	TranscodingProfile   *transcodingProfileDataSourceModel `tfsdk:"transcoding_profile"`
+	Timeouts             timeouts.Value                     `tfsdk:"timeouts"`
 }
 
 type sourceLiteModel struct {
@@ -1099,6 +1305,13 @@ type liveAdReplacementLiteModel struct {
 	SpotAware spotAwareModel    `tfsdk:"spot_aware"`
 }
 
+type vodAdInsertionLiteModel struct {
+	AdServer adServerLiteModel `tfsdk:"ad_server"`
+	PreRoll  *vodAdBreakModel  `tfsdk:"pre_roll"`
+	MidRoll  *vodMidRollModel  `tfsdk:"mid_roll"`
+	PostRoll *vodAdBreakModel  `tfsdk:"post_roll"`
+}
+
 type adServerLiteModel struct {
 	ID   types.Int64  `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
 
@@ -17,10 +16,7 @@ var (
 )
 
 func TestAccServiceAdInsertion_Basic(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -40,10 +36,7 @@ func TestAccServiceAdInsertion_Basic(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_UpdateName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	initialName := "tf-acc-service-ad-initial"
 	updatedName := "tf-acc-service-ad-updated"
@@ -67,10 +60,7 @@ func TestAccServiceAdInsertion_UpdateName(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_UpdateSlate(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	initialName := "tf-acc-slate-initial"
 	updatedName := "tf-acc-slate-updated"
@@ -92,10 +82,7 @@ func TestAccServiceAdInsertion_UpdateSlate(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_UpdateLiveName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	initialName := "tf-acc-live-initial"
 	updatedName := "tf-acc-live-updated"
@@ -117,10 +104,7 @@ func TestAccServiceAdInsertion_UpdateLiveName(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_UpdateLiveSource(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -140,10 +124,7 @@ func TestAccServiceAdInsertion_UpdateLiveSource(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_ImportStateAndDrift(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -164,10 +145,7 @@ func TestAccServiceAdInsertion_ImportStateAndDrift(t *testing.T) {
 // // --- Error cases: try creating with invalid source/adserver id (should error out) ---
 
 func TestAccServiceAdInsertion_InvalidSource(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	badID := 999999999
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -182,10 +160,7 @@ func TestAccServiceAdInsertion_InvalidSource(t *testing.T) {
 }
 
 func TestAccServiceAdInsertion_InvalidAdServer(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	badID := 999999999
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -491,7 +466,7 @@ resource "bpkio_service_ad_insertion" "adservice" {
     id = data.bpkio_transcoding_profile.test.id
   }
 }
-`, SlateURL, AdServerURL, apiKey, badSourceID)
+`, apiKey, SlateURL, AdServerURL, badSourceID)
 }
 
 // Invalid ad server (bad id)
@@ -539,3 +514,195 @@ resource "bpkio_service_ad_insertion" "test" {
 }
 `, apiKey, LiveURL, SlateURL, badAdServerID)
 }
+
+func TestAccServiceAdInsertion_Drift(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_ad_insertion.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAdInsertionConfig(apiKey),
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				// Someone renames the service outside of Terraform.
+				PreConfig: func() {
+					testAccFakeAPI.SetServiceField(id, "name", "renamed-out-of-band")
+				},
+				Config:             testAccServiceAdInsertionConfig(apiKey),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccServiceAdInsertionConfig(apiKey),
+				Check:  resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-adinsertion"),
+			},
+		},
+	})
+}
+
+func TestAccServiceAdInsertion_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_ad_insertion.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAdInsertionConfig(apiKey),
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteService(id)
+				},
+				Config:             testAccServiceAdInsertionConfig(apiKey),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccServiceAdInsertionConfig(apiKey),
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+func TestAccServiceAdInsertion_VodAdInsertion(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_ad_insertion.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAdInsertionConfigWithVod(apiKey, "adserver", `
+    pre_roll = {
+      max_duration = 30
+    }
+    mid_roll = {
+      positions = [300, 600]
+    }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "vod_ad_insertion.ad_server.id", "bpkio_source_adserver.adserver", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.ad_server.name", "tf-acc-adserver"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.pre_roll.max_duration", "30"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.mid_roll.positions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.mid_roll.positions.1", "600"),
+					resource.TestCheckNoResourceAttr(resourceName, "vod_ad_insertion.mid_roll.max_duration"),
+					resource.TestCheckNoResourceAttr(resourceName, "vod_ad_insertion.post_roll"),
+					resource.TestCheckNoResourceAttr(resourceName, "live_ad_replacement"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceAdInsertionConfigWithVod(apiKey, "adserver", `
+    mid_roll = {
+      positions    = [300, 600, 900]
+      max_duration = 60
+    }
+    post_roll = {}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "vod_ad_insertion.pre_roll"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.mid_roll.positions.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.mid_roll.max_duration", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "vod_ad_insertion.post_roll.%"),
+				),
+			},
+			{
+				// Switching ad servers is an in-place update that reads
+				// back the new ad server.
+				Config: testAccServiceAdInsertionConfigWithVod(apiKey, "other", `
+    mid_roll = {
+      positions    = [300, 600, 900]
+      max_duration = 60
+    }
+    post_roll = {}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "vod_ad_insertion.ad_server.id", "bpkio_source_adserver.other", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vod_ad_insertion.ad_server.name", "tf-acc-adserver-other"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceAdInsertion_VodInvalidMidRoll(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAdInsertionConfigWithVod(apiKey, "adserver", `
+    mid_roll = {
+      positions = [300, 300]
+    }`),
+				ExpectError: regexp.MustCompile(`(?i)duplicate`),
+			},
+		},
+	})
+}
+
+// VOD ad insertion on an asset source; adServer names the
+// bpkio_source_adserver resource queried for the breaks, "adserver" or
+// "other", and breaks is the body of the vod_ad_insertion attribute after
+// ad_server.
+func testAccServiceAdInsertionConfigWithVod(apiKey, adServer, breaks string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%[1]s"
+}
+
+resource "bpkio_source_asset" "asset" {
+  name = "tf-acc-asset"
+  url  = "%[2]s"
+}
+
+resource "bpkio_source_adserver" "adserver" {
+  name = "tf-acc-adserver"
+  url  = "%[3]s"
+}
+
+resource "bpkio_source_adserver" "other" {
+  name = "tf-acc-adserver-other"
+  url  = "%[3]s"
+}
+
+data "bpkio_transcoding_profile" "test" {
+  id = 5763
+}
+
+resource "bpkio_service_ad_insertion" "test" {
+  name = "tf-acc-adinsertion-vod"
+
+  source = {
+    id = bpkio_source_asset.asset.id
+  }
+
+  transcoding_profile = {
+    id = data.bpkio_transcoding_profile.test.id
+  }
+
+  vod_ad_insertion = {
+    ad_server = {
+      id = bpkio_source_adserver.%[4]s.id
+    }
+%[5]s
+  }
+}
+`, apiKey, "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8", AdServerURL, adServer, breaks)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceContentReplacementDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceContentReplacementDataSource{}
+)
+
+// serviceContentReplacementDataSource is the data source implementation.
+type serviceContentReplacementDataSource struct {
+	client *bpkioClient
+}
+
+// NewServiceContentReplacementDataSource is a helper function to simplify the provider implementation.
+func NewServiceContentReplacementDataSource() datasource.DataSource {
+	return &serviceContentReplacementDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceContentReplacementDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *serviceContentReplacementDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_content_replacement"
+}
+
+// Schema defines the schema for the data source.
+func (d *serviceContentReplacementDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sourceAttributes := func(what string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The ID of the %s.", what),
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The name of the %s.", what),
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The type of the %s.", what),
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The URL of the %s.", what),
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The description of the %s.", what),
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("The format of the %s.", what),
+			},
+			"multi_period": schema.BoolAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is multi-period.", what),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Returns a content replacement service.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the service.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the service.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the service.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the service.",
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The creation date of the service.",
+			},
+			"update_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last update date of the service.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the service.",
+			},
+			"tags": schema.ListAttribute{
+				Computed:    true,
+				Description: "Tags associated with the service.",
+				ElementType: types.StringType,
+			},
+			"source": schema.SingleNestedAttribute{
+				Attributes:  sourceAttributes("live source"),
+				Computed:    true,
+				Description: "The live source of the service.",
+			},
+			"replacement": schema.SingleNestedAttribute{
+				Attributes:  sourceAttributes("replacement slate or asset"),
+				Computed:    true,
+				Description: "The slate or asset played during replacement windows.",
+			},
+			"transcoding_profile": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the transcoding profile.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the transcoding profile.",
+					},
+					"internal_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The internal ID of the transcoding profile.",
+					},
+					"content": schema.StringAttribute{
+						CustomType:  jsonStringType{},
+						Computed:    true,
+						Description: "The JSON content of the transcoding profile.",
+					},
+				},
+				Computed:    true,
+				Description: "The transcoding profile of the service.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceContentReplacementDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config serviceContentReplacementModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := d.client.GetContentReplacement(ctx, uint(config.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Content Replacement Service",
+			fmt.Sprintf("Could not read content replacement service ID %d: %s", config.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set state
+	state, diags := flattenContentReplacement(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func flattenContentReplacement(ctx context.Context, s contentReplacementOutput) (serviceContentReplacementModel, diag.Diagnostics) {
+	tags := s.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	tagsList, diags := types.ListValueFrom(ctx, types.StringType, tags)
+
+	return serviceContentReplacementModel{
+		ID:                 types.Int64Value(int64(s.Id)),
+		Name:               types.StringValue(s.Name),
+		Type:               types.StringValue(s.Type),
+		URL:                types.StringValue(s.Url),
+		CreationDate:       types.StringValue(s.CreationDate),
+		UpdateDate:         types.StringValue(s.UpdateDate),
+		State:              types.StringValue(s.State),
+		Tags:               tagsList,
+		Source:             flattenServiceSource(s.Source),
+		Replacement:        flattenServiceSource(s.Replacement),
+		TranscodingProfile: flattenServiceTranscodingProfile(s.TranscodingProfile),
+	}, diags
+}
+
+// flattenServiceSource maps a source referenced by a service, or returns nil
+// when the reference is not set.
+func flattenServiceSource(s serviceSource) *sourceLiteModel {
+	if s.Id == 0 {
+		return nil
+	}
+	return &sourceLiteModel{
+		ID:          types.Int64Value(int64(s.Id)),
+		Name:        types.StringValue(s.Name),
+		Type:        types.StringValue(s.Type),
+		URL:         types.StringValue(s.Url),
+		Description: types.StringValue(s.Description),
+		Format:      types.StringValue(s.Format),
+		MultiPeriod: types.BoolValue(s.MultiPeriod),
+	}
+}
+
+// flattenServiceTranscodingProfile maps the transcoding profile referenced by
+// a service, or returns nil when the service has none.
+func flattenServiceTranscodingProfile(p broadpeakio.TranscodingProfileOutput) *transcodingProfileDataSourceModel {
+	if p.Id == 0 {
+		return nil
+	}
+	return &transcodingProfileDataSourceModel{
+		ID:         types.Int64Value(int64(p.Id)),
+		Name:       types.StringValue(p.Name),
+		InternalId: types.StringValue(p.InternalId),
+		Content:    newJSONString(p.Content),
+	}
+}
+
+// serviceContentReplacementModel maps the content replacement service schema
+// data, shared by the resource and the data source.
+type serviceContentReplacementModel struct {
+	ID                 types.Int64                        `tfsdk:"id"`
+	Name               types.String                       `tfsdk:"name"`
+	Type               types.String                       `tfsdk:"type"`
+	URL                types.String                       `tfsdk:"url"`
+	CreationDate       types.String                       `tfsdk:"creation_date"`
+	UpdateDate         types.String                       `tfsdk:"update_date"`
+	State              types.String                       `tfsdk:"state"`
+	Tags               types.List                         `tfsdk:"tags"`
+	Source             *sourceLiteModel                   `tfsdk:"source"`
+	Replacement        *sourceLiteModel                   `tfsdk:"replacement"`
+	TranscodingProfile *transcodingProfileDataSourceModel `tfsdk:"transcoding_profile"`
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenContentReplacement(t *testing.T) {
+	in := contentReplacementOutput{
+		ContentReplacementOutput: broadpeakio.ContentReplacementOutput{
+			Id:   7,
+			Name: "blackout",
+			Url:  "https://stream.broadpeak.io/7/",
+		},
+		Type:        "content-replacement",
+		State:       "enabled",
+		Source:      serviceSource{Source: broadpeakio.Source{Id: 2, Name: "live", Type: "live"}, Format: "hls"},
+		Replacement: serviceSource{Source: broadpeakio.Source{Id: 3, Name: "slate", Type: "slate"}},
+	}
+
+	out, diags := flattenContentReplacement(context.Background(), in)
+	require.False(t, diags.HasError())
+	require.Equal(t, types.Int64Value(7), out.ID)
+	require.Equal(t, types.StringValue("content-replacement"), out.Type)
+	require.Empty(t, out.Tags.Elements())
+	require.Equal(t, types.StringValue("hls"), out.Source.Format)
+	require.Equal(t, types.Int64Value(3), out.Replacement.ID)
+	require.Nil(t, out.TranscodingProfile)
+}
+
+func TestAccServiceContentReplacementDataSource_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement-ds", "bpkio_source_slate.slate", `
+  tags = ["blackout"]
+`) + `
+data "bpkio_service_content_replacement" "test" {
+  id = bpkio_service_content_replacement.test.id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bpkio_service_content_replacement.test", "id", "bpkio_service_content_replacement.test", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_service_content_replacement.test", "name", "tf-acc-content-replacement-ds"),
+					resource.TestCheckResourceAttr("data.bpkio_service_content_replacement.test", "tags.0", "blackout"),
+					resource.TestCheckResourceAttrPair("data.bpkio_service_content_replacement.test", "source.id", "bpkio_source_live.live", "id"),
+					resource.TestCheckResourceAttrPair("data.bpkio_service_content_replacement.test", "replacement.url", "bpkio_source_slate.slate", "url"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceContentReplacementResource{}
+	_ resource.ResourceWithConfigure   = &serviceContentReplacementResource{}
+	_ resource.ResourceWithImportState = &serviceContentReplacementResource{}
+)
+
+// NewServiceContentReplacementResource is a helper function to simplify the provider implementation.
+func NewServiceContentReplacementResource() resource.Resource {
+	return &serviceContentReplacementResource{}
+}
+
+// serviceContentReplacementResource is the resource implementation.
+type serviceContentReplacementResource struct {
+	client *bpkioClient
+}
+
+// serviceContentReplacementAPIFields maps the request fields the API may
+// reject to the attributes they are read from.
+var serviceContentReplacementAPIFields = map[string]path.Path{
+	"name":                  path.Root("name"),
+	"tags":                  path.Root("tags"),
+	"state":                 path.Root("state"),
+	"source":                path.Root("source"),
+	"source.id":             path.Root("source").AtName("id"),
+	"replacement":           path.Root("replacement"),
+	"replacement.id":        path.Root("replacement").AtName("id"),
+	"transcodingProfile":    path.Root("transcoding_profile"),
+	"transcodingProfile.id": path.Root("transcoding_profile").AtName("id"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceContentReplacementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceContentReplacementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_content_replacement"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceContentReplacementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a content replacement service, which swaps a live source for a slate or an asset during blackout or rights-restricted windows.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the content replacement service.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the content replacement service.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the service, always `content-replacement`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the content replacement service. This is the endpoint where the service can be accessed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date of the content replacement service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date of the content replacement service.",
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "State of the content replacement service. Possible values are `enabled`, `paused` or `bypassed` (Default: `enabled`).",
+				Default:     stringdefault.StaticString("enabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "paused", "bypassed"),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Tags for the content replacement service.",
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"source": schema.SingleNestedAttribute{
+				Attributes:  serviceSourceLiteAttributes("live source", true),
+				Required:    true,
+				Description: "Live source of the service. Changing it forces a new service to be created.",
+			},
+			"replacement": schema.SingleNestedAttribute{
+				Attributes:  serviceSourceLiteAttributes("slate or asset", false),
+				Required:    true,
+				Description: "Slate or asset played instead of the live source during replacement windows.",
+			},
+			"transcoding_profile": schema.SingleNestedAttribute{
+				Attributes:  serviceTranscodingProfileAttributes(),
+				Optional:    true,
+				Description: "Transcoding profile used to condition the replacement content.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// serviceSourceLiteAttributes returns the attributes of a source referenced
+// by a service, of which only the ID is configurable. The computed attributes
+// are only carried over from state when changing the reference forces a new
+// service, otherwise they would be kept when the reference changes in place.
+func serviceSourceLiteAttributes(what string, requiresReplace bool) map[string]schema.Attribute {
+	var (
+		idModifiers     []planmodifier.Int64
+		stringModifiers []planmodifier.String
+		boolModifiers   []planmodifier.Bool
+	)
+	if requiresReplace {
+		idModifiers = []planmodifier.Int64{int64planmodifier.RequiresReplace()}
+		stringModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		boolModifiers = []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()}
+	}
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Required:      true,
+			Description:   fmt.Sprintf("ID of the %s.", what),
+			PlanModifiers: idModifiers,
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s.", what),
+		},
+		"type": schema.StringAttribute{
+			Computed:      true,
+			Description:   fmt.Sprintf("Type of the %s.", what),
+			PlanModifiers: stringModifiers,
+		},
+		"url": schema.StringAttribute{
+			Computed:      true,
+			Description:   fmt.Sprintf("URL of the %s.", what),
+			PlanModifiers: stringModifiers,
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("Description of the %s.", what),
+		},
+		"format": schema.StringAttribute{
+			Computed:      true,
+			Description:   fmt.Sprintf("Format of the %s.", what),
+			PlanModifiers: stringModifiers,
+		},
+		"multi_period": schema.BoolAttribute{
+			Computed:      true,
+			Description:   fmt.Sprintf("Whether the %s is multi-period.", what),
+			PlanModifiers: boolModifiers,
+		},
+	}
+}
+
+// serviceTranscodingProfileAttributes returns the attributes of the
+// transcoding profile referenced by a service, of which only the ID is
+// configurable.
+func serviceTranscodingProfileAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the transcoding profile.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the transcoding profile.",
+		},
+		"internal_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Internal ID of the transcoding profile.",
+		},
+		"content": schema.StringAttribute{
+			CustomType:  jsonStringType{},
+			Computed:    true,
+			Description: "JSON content of the transcoding profile.",
+		},
+	}
+}
+
+// serviceContentReplacementResourceModel is the state of a content replacement
+// service resource: the service plus the operation timeouts.
+type serviceContentReplacementResourceModel struct {
+	serviceContentReplacementModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceContentReplacementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceContentReplacementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := expandContentReplacement(ctx, plan.serviceContentReplacementModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new service
+	service, err := r.client.CreateContentReplacement(ctx, input)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating Content-Replacement", "Could not create content replacement service", err, serviceContentReplacementAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state, diags := flattenContentReplacement(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, serviceContentReplacementResourceModel{state, plan.Timeouts})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceContentReplacementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serviceContentReplacementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetContentReplacement(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Content replacement service no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Content Replacement Service",
+			fmt.Sprintf("Could not read content replacement service ID %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state.serviceContentReplacementModel, diags = flattenContentReplacement(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceContentReplacementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan serviceContentReplacementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := expandContentReplacement(ctx, plan.serviceContentReplacementModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(plan.ID.ValueInt64())
+
+	// Update existing service
+	if _, err := r.client.UpdateContentReplacement(ctx, serviceID, input); err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating Content-Replacement", fmt.Sprintf("Could not update content replacement service ID %d", serviceID), err, serviceContentReplacementAPIFields)
+		return
+	}
+
+	// Fetch the service again, so that computed attributes reflect the update
+	service, err := r.client.GetContentReplacement(ctx, serviceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Content Replacement Service",
+			fmt.Sprintf("Could not fetch content replacement service ID %d after update: %s", serviceID, err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	state, diags := flattenContentReplacement(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, serviceContentReplacementResourceModel{state, plan.Timeouts})
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceContentReplacementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceContentReplacementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing service
+	_, err := r.client.DeleteContentReplacement(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Content Replacement Service",
+			"Could not delete content replacement service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *serviceContentReplacementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing content replacement service",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandContentReplacement builds the API request for a content replacement
+// service from its model.
+func expandContentReplacement(ctx context.Context, m serviceContentReplacementModel) (contentReplacementInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tags := []string{}
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		diags = m.Tags.ElementsAs(ctx, &tags, false)
+	}
+
+	in := contentReplacementInput{
+		CreateContentReplacementInput: broadpeakio.CreateContentReplacementInput{
+			Name:        m.Name.ValueString(),
+			Source:      &broadpeakio.Identifiable{Id: uint(m.Source.ID.ValueInt64())},
+			Replacement: &broadpeakio.Identifiable{Id: uint(m.Replacement.ID.ValueInt64())},
+		},
+		Tags:  tags,
+		State: m.State.ValueString(),
+	}
+	if m.TranscodingProfile != nil {
+		in.TranscodingProfile = &broadpeakio.Identifiable{Id: uint(m.TranscodingProfile.ID.ValueInt64())}
+	}
+	return in, diags
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccServiceContentReplacement_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_content_replacement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement", "bpkio_source_slate.slate", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "content-replacement"),
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.id", "bpkio_source_live.live", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source.name", "tf-acc-cr-live"),
+					resource.TestCheckResourceAttrPair(resourceName, "replacement.id", "bpkio_source_slate.slate", "id"),
+					resource.TestCheckResourceAttr(resourceName, "replacement.type", "slate"),
+					resource.TestCheckNoResourceAttr(resourceName, "transcoding_profile"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Switch to an asset replacement, with a transcoding profile,
+				// tags and a paused state, in place.
+				Config: testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement-updated", "bpkio_source_asset.asset", `
+  state = "paused"
+  tags  = ["blackout", "sports"]
+
+  transcoding_profile = {
+    id = 5763
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-content-replacement-updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "replacement.type", "asset"),
+					resource.TestCheckResourceAttr(resourceName, "transcoding_profile.id", "5763"),
+					resource.TestCheckResourceAttrSet(resourceName, "transcoding_profile.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceContentReplacement_SourceForcesReplacement(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_content_replacement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement", "bpkio_source_slate.slate", ""),
+			},
+			{
+				Config: testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement", "bpkio_source_slate.slate", "") + `
+resource "bpkio_source_live" "other" {
+  name = "tf-acc-cr-live-other"
+  url  = "https://test-streams.mux.dev/x36xhzz/x36xhzz.m3u8"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config: testAccServiceContentReplacementConfigWithSource(apiKey, "bpkio_source_live.other"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPair(resourceName, "source.id", "bpkio_source_live.other", "id"),
+			},
+		},
+	})
+}
+
+func TestAccServiceContentReplacement_InvalidReplacement(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// A live source cannot replace another live source.
+				Config:      testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement", "bpkio_source_live.live", ""),
+				ExpectError: regexp.MustCompile(`(?i)403|forbidden|not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccServiceContentReplacement_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_content_replacement.test"
+	config := testAccServiceContentReplacementConfig(apiKey, "tf-acc-content-replacement", "bpkio_source_slate.slate", "")
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteService(id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+// testAccServiceContentReplacementSources declares the sources a content
+// replacement service can reference.
+func testAccServiceContentReplacementSources(apiKey string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_live" "live" {
+  name = "tf-acc-cr-live"
+  url  = "%s"
+}
+
+resource "bpkio_source_slate" "slate" {
+  name = "tf-acc-cr-slate"
+  url  = "%s"
+}
+
+resource "bpkio_source_asset" "asset" {
+  name = "tf-acc-cr-asset"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8"
+}
+`, apiKey, LiveURL, SlateURL)
+}
+
+// testAccServiceContentReplacementConfig replaces the live source with the
+// given replacement; extra is added to the body of the service.
+func testAccServiceContentReplacementConfig(apiKey, name, replacement, extra string) string {
+	return testAccServiceContentReplacementSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_service_content_replacement" "test" {
+  name = "%s"
+
+  source = {
+    id = bpkio_source_live.live.id
+  }
+
+  replacement = {
+    id = %s.id
+  }
+%s
+}
+`, name, replacement, extra)
+}
+
+func testAccServiceContentReplacementConfigWithSource(apiKey, source string) string {
+	return testAccServiceContentReplacementSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_source_live" "other" {
+  name = "tf-acc-cr-live-other"
+  url  = "https://test-streams.mux.dev/x36xhzz/x36xhzz.m3u8"
+}
+
+resource "bpkio_service_content_replacement" "test" {
+  name = "tf-acc-content-replacement"
+
+  source = {
+    id = %s.id
+  }
+
+  replacement = {
+    id = bpkio_source_slate.slate.id
+  }
+}
+`, source)
+}
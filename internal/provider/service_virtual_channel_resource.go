@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &serviceVirtualChannelResource{}
+	_ resource.ResourceWithConfigure   = &serviceVirtualChannelResource{}
+	_ resource.ResourceWithImportState = &serviceVirtualChannelResource{}
+)
+
+// NewServiceVirtualChannelResource is a helper function to simplify the provider implementation.
+func NewServiceVirtualChannelResource() resource.Resource {
+	return &serviceVirtualChannelResource{}
+}
+
+// serviceVirtualChannelResource is the resource implementation.
+type serviceVirtualChannelResource struct {
+	client *bpkioClient
+}
+
+// serviceVirtualChannelAPIFields maps the request fields the API may reject to
+// the attributes they are read from.
+var serviceVirtualChannelAPIFields = map[string]path.Path{
+	"name":                          path.Root("name"),
+	"tags":                          path.Root("tags"),
+	"state":                         path.Root("state"),
+	"baseLive":                      path.Root("base_live"),
+	"baseLive.id":                   path.Root("base_live").AtName("id"),
+	"defaultSlate":                  path.Root("default_slate"),
+	"defaultSlate.id":               path.Root("default_slate").AtName("id"),
+	"transcodingProfile":            path.Root("transcoding_profile"),
+	"transcodingProfile.id":         path.Root("transcoding_profile").AtName("id"),
+	"adBreakInsertion":              path.Root("ad_break_insertion"),
+	"adBreakInsertion.adServer":     path.Root("ad_break_insertion").AtName("ad_server"),
+	"adBreakInsertion.adServer.id":  path.Root("ad_break_insertion").AtName("ad_server").AtName("id"),
+	"adBreakInsertion.gapFiller":    path.Root("ad_break_insertion").AtName("gap_filler"),
+	"adBreakInsertion.gapFiller.id": path.Root("ad_break_insertion").AtName("gap_filler").AtName("id"),
+	"enableAdTranscoding":           path.Root("enable_ad_transcoding"),
+	"serverSideAdTracking.enable":   path.Root("server_side_ad_tracking").AtName("enable"),
+	"serverSideAdTracking.checkAdMediaSegmentAvailability": path.Root("server_side_ad_tracking").AtName("check_ad_media_segment_availability"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serviceVirtualChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *serviceVirtualChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_virtual_channel"
+}
+
+// Schema defines the schema for the resource.
+func (r *serviceVirtualChannelResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a virtual channel service, a linear channel programmed from a base live source and scheduled slots.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the virtual channel.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the virtual channel.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the service, always `virtual-channel`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the virtual channel. This is the endpoint where the channel can be accessed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation date of the virtual channel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"update_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last update date of the virtual channel.",
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "State of the virtual channel. Possible values are `enabled`, `paused` or `bypassed` (Default: `enabled`).",
+				Default:     stringdefault.StaticString("enabled"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("enabled", "paused", "bypassed"),
+				},
+			},
+			"tags": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Tags for the virtual channel.",
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"base_live": schema.SingleNestedAttribute{
+				Attributes:  serviceSourceLiteAttributes("base live source", true),
+				Required:    true,
+				Description: "Live source played outside of scheduled slots. Changing it forces a new virtual channel to be created.",
+			},
+			"default_slate": schema.SingleNestedAttribute{
+				Attributes:  serviceSourceLiteAttributes("default slate", false),
+				Optional:    true,
+				Description: "Slate played when neither a slot nor the base live source can be played.",
+			},
+			"transcoding_profile": schema.SingleNestedAttribute{
+				Attributes:  serviceTranscodingProfileAttributes(),
+				Optional:    true,
+				Description: "Transcoding profile used to condition the slot and ad content.",
+			},
+			"ad_break_insertion": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"ad_server": schema.SingleNestedAttribute{
+						Attributes:  serviceRefLiteAttributes("ad server"),
+						Required:    true,
+						Description: "Ad server queried for the ad breaks.",
+					},
+					"gap_filler": schema.SingleNestedAttribute{
+						Attributes:  serviceRefLiteAttributes("gap filler"),
+						Optional:    true,
+						Description: "Slate or asset played when the ad server does not fill an ad break.",
+					},
+				},
+				Optional:    true,
+				Description: "Ad insertion configuration for the ad breaks of the channel.",
+			},
+			"enable_ad_transcoding": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether ads are transcoded to match the channel (Default: `false`).",
+				Default:     booldefault.StaticBool(false),
+			},
+			"server_side_ad_tracking": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether ad tracking beacons are sent by the server (Default: `false`).",
+						Default:     booldefault.StaticBool(false),
+					},
+					"check_ad_media_segment_availability": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether ad media segments are checked before tracking (Default: `false`).",
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+				Optional:    true,
+				Computed:    true,
+				Description: "Server-side ad tracking configuration.",
+				Default: objectdefault.StaticValue(types.ObjectValueMust(
+					map[string]attr.Type{
+						"enable":                              types.BoolType,
+						"check_ad_media_segment_availability": types.BoolType,
+					},
+					map[string]attr.Value{
+						"enable":                              types.BoolValue(false),
+						"check_ad_media_segment_availability": types.BoolValue(false),
+					},
+				)),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// serviceRefLiteAttributes returns the attributes of an ad server or gap
+// filler referenced by a service, of which only the ID is configurable.
+func serviceRefLiteAttributes(what string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Required:    true,
+			Description: fmt.Sprintf("ID of the %s.", what),
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s.", what),
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("Type of the %s.", what),
+		},
+		"url": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("URL of the %s.", what),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serviceVirtualChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan serviceVirtualChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := expandVirtualChannel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new virtual channel
+	service, err := r.client.CreateVirtualChannel(ctx, input)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating Virtual-Channel", "Could not create virtual channel", err, serviceVirtualChannelAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state, diags := flattenVirtualChannel(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serviceVirtualChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state serviceVirtualChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.GetVirtualChannel(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Virtual channel no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Virtual Channel",
+			fmt.Sprintf("Could not read virtual channel ID %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	newState, diags := flattenVirtualChannel(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.Timeouts = state.Timeouts
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *serviceVirtualChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan serviceVirtualChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := expandVirtualChannel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := uint(plan.ID.ValueInt64())
+
+	tflog.Debug(ctx, "Update - Update Doc sent to BPKIO", map[string]interface{}{"id": channelID, "updates": input})
+
+	// Update existing virtual channel
+	if _, err := r.client.UpdateVirtualChannel(ctx, channelID, input); err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating Virtual-Channel", fmt.Sprintf("Could not update virtual channel ID %d", channelID), err, serviceVirtualChannelAPIFields)
+		return
+	}
+
+	// Fetch the virtual channel again, so that computed attributes reflect the update
+	service, err := r.client.GetVirtualChannel(ctx, channelID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Virtual Channel",
+			fmt.Sprintf("Could not fetch virtual channel ID %d after update: %s", channelID, err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	state, diags := flattenVirtualChannel(ctx, service)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *serviceVirtualChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state serviceVirtualChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing virtual channel
+	_, err := r.client.DeleteVirtualChannel(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Virtual Channel",
+			"Could not delete virtual channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *serviceVirtualChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing virtual channel",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandVirtualChannel builds the API request for a virtual channel from its
+// model.
+func expandVirtualChannel(ctx context.Context, m serviceVirtualChannelResourceModel) (virtualChannelInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tags := []string{}
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		diags = m.Tags.ElementsAs(ctx, &tags, false)
+	}
+
+	in := virtualChannelInput{
+		CreateVirtualChannelInput: broadpeakio.CreateVirtualChannelInput{
+			Name:                m.Name.ValueString(),
+			BaseLive:            &broadpeakio.Identifiable{Id: uint(m.BaseLive.ID.ValueInt64())},
+			EnableAdTranscoding: m.EnableAdTranscoding.ValueBool(),
+		},
+		Tags:  tags,
+		State: m.State.ValueString(),
+	}
+	if m.DefaultSlate != nil {
+		in.DefaultSlate = &broadpeakio.Identifiable{Id: uint(m.DefaultSlate.ID.ValueInt64())}
+	}
+	if m.TranscodingProfile != nil {
+		in.TranscodingProfile = &broadpeakio.Identifiable{Id: uint(m.TranscodingProfile.ID.ValueInt64())}
+	}
+	if m.AdBreakInsertion != nil {
+		in.AdBreakInsertion = &adBreakInsertion{
+			AdServer: &broadpeakio.Identifiable{Id: uint(m.AdBreakInsertion.AdServer.ID.ValueInt64())},
+		}
+		if m.AdBreakInsertion.GapFiller != nil {
+			in.AdBreakInsertion.GapFiller = &broadpeakio.Identifiable{Id: uint(m.AdBreakInsertion.GapFiller.ID.ValueInt64())}
+		}
+	}
+	if m.ServerSideAdTracking != nil {
+		in.ServerSideAdTracking = &broadpeakio.ServerSideAdTracking{
+			Enable:                          m.ServerSideAdTracking.Enable.ValueBool(),
+			CheckAdMediaSegmentAvailability: m.ServerSideAdTracking.CheckAdMediaSegmentAvailability.ValueBool(),
+		}
+	}
+	return in, diags
+}
+
+// flattenVirtualChannel maps a virtual channel returned by the API to its
+// model.
+func flattenVirtualChannel(ctx context.Context, s virtualChannelOutput) (serviceVirtualChannelResourceModel, diag.Diagnostics) {
+	tags := s.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	tagsList, diags := types.ListValueFrom(ctx, types.StringType, tags)
+
+	m := serviceVirtualChannelResourceModel{
+		ID:                  types.Int64Value(int64(s.Id)),
+		Name:                types.StringValue(s.Name),
+		Type:                types.StringValue(s.Type),
+		URL:                 types.StringValue(s.Url),
+		CreationDate:        types.StringValue(s.CreationDate),
+		UpdateDate:          types.StringValue(s.UpdateDate),
+		State:               types.StringValue(s.State),
+		Tags:                tagsList,
+		BaseLive:            flattenServiceSource(s.BaseLive),
+		DefaultSlate:        flattenServiceSource(s.DefaultSlate),
+		TranscodingProfile:  flattenServiceTranscodingProfile(s.TranscodingProfile),
+		EnableAdTranscoding: types.BoolValue(s.EnableAdTranscoding),
+		ServerSideAdTracking: &serverSideAdTrackingModel{
+			Enable:                          types.BoolValue(s.ServerSideAdTracking.Enable),
+			CheckAdMediaSegmentAvailability: types.BoolValue(s.ServerSideAdTracking.CheckAdMediaSegmentAvailability),
+		},
+	}
+
+	if abi := s.AdBreakInsertion; abi.AdServer.Id != 0 {
+		m.AdBreakInsertion = &adBreakInsertionLiteModel{
+			AdServer: adServerLiteModel{
+				ID:   types.Int64Value(int64(abi.AdServer.Id)),
+				Name: types.StringValue(abi.AdServer.Name),
+				Type: types.StringValue(abi.AdServer.Type),
+				URL:  types.StringValue(abi.AdServer.Url),
+			},
+		}
+		if abi.GapFiller.Id != 0 {
+			m.AdBreakInsertion.GapFiller = &gapFillerModel{
+				ID:   types.Int64Value(int64(abi.GapFiller.Id)),
+				Name: types.StringValue(abi.GapFiller.Name),
+				Type: types.StringValue(abi.GapFiller.Type),
+				URL:  types.StringValue(abi.GapFiller.Url),
+			}
+		}
+	}
+	return m, diags
+}
+
+// serviceVirtualChannelResourceModel maps the virtual channel schema data.
+type serviceVirtualChannelResourceModel struct {
+	ID                   types.Int64                        `tfsdk:"id"`
+	Name                 types.String                       `tfsdk:"name"`
+	Type                 types.String                       `tfsdk:"type"`
+	URL                  types.String                       `tfsdk:"url"`
+	CreationDate         types.String                       `tfsdk:"creation_date"`
+	UpdateDate           types.String                       `tfsdk:"update_date"`
+	State                types.String                       `tfsdk:"state"`
+	Tags                 types.List                         `tfsdk:"tags"`
+	BaseLive             *sourceLiteModel                   `tfsdk:"base_live"`
+	DefaultSlate         *sourceLiteModel                   `tfsdk:"default_slate"`
+	TranscodingProfile   *transcodingProfileDataSourceModel `tfsdk:"transcoding_profile"`
+	AdBreakInsertion     *adBreakInsertionLiteModel         `tfsdk:"ad_break_insertion"`
+	EnableAdTranscoding  types.Bool                         `tfsdk:"enable_ad_transcoding"`
+	ServerSideAdTracking *serverSideAdTrackingModel         `tfsdk:"server_side_ad_tracking"`
+	Timeouts             timeouts.Value                     `tfsdk:"timeouts"`
+}
+
+type adBreakInsertionLiteModel struct {
+	AdServer  adServerLiteModel `tfsdk:"ad_server"`
+	GapFiller *gapFillerModel   `tfsdk:"gap_filler"`
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenVirtualChannel(t *testing.T) {
+	in := virtualChannelOutput{
+		VirtualChannelOutput: broadpeakio.VirtualChannelOutput{
+			Id:   7,
+			Name: "vc",
+			Url:  "https://stream.broadpeak.io/vc",
+		},
+		Type:     "virtual-channel",
+		State:    "enabled",
+		BaseLive: serviceSource{Source: broadpeakio.Source{Id: 1, Name: "live", Type: "live"}, Format: "hls"},
+	}
+	in.AdBreakInsertion.AdServer = broadpeakio.AdServer{Id: 3, Name: "ads", Type: "ad-server"}
+	in.ServerSideAdTracking.Enable = true
+
+	m, diags := flattenVirtualChannel(context.Background(), in)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(7), m.ID.ValueInt64())
+	assert.Equal(t, "virtual-channel", m.Type.ValueString())
+	assert.Equal(t, 0, len(m.Tags.Elements()))
+	assert.Equal(t, int64(1), m.BaseLive.ID.ValueInt64())
+	assert.Equal(t, "hls", m.BaseLive.Format.ValueString())
+	assert.Nil(t, m.DefaultSlate)
+	assert.Nil(t, m.TranscodingProfile)
+	assert.Equal(t, int64(3), m.AdBreakInsertion.AdServer.ID.ValueInt64())
+	assert.Nil(t, m.AdBreakInsertion.GapFiller)
+	assert.True(t, m.ServerSideAdTracking.Enable.ValueBool())
+	assert.False(t, m.ServerSideAdTracking.CheckAdMediaSegmentAvailability.ValueBool())
+}
+
+func TestAccServiceVirtualChannel_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_virtual_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVirtualChannelConfig(apiKey, "tf-acc-virtual-channel", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "virtual-channel"),
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "base_live.id", "bpkio_source_live.live", "id"),
+					resource.TestCheckResourceAttr(resourceName, "base_live.name", "tf-acc-vc-live"),
+					resource.TestCheckResourceAttr(resourceName, "enable_ad_transcoding", "false"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_ad_tracking.enable", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "default_slate"),
+					resource.TestCheckNoResourceAttr(resourceName, "ad_break_insertion"),
+					resource.TestCheckNoResourceAttr(resourceName, "transcoding_profile"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Add a default slate, ad insertion, tags and a transcoding
+				// profile, in place.
+				Config: testAccServiceVirtualChannelConfig(apiKey, "tf-acc-virtual-channel-updated", `
+  state = "paused"
+  tags  = ["linear", "sports"]
+
+  default_slate = {
+    id = bpkio_source_slate.slate.id
+  }
+
+  ad_break_insertion = {
+    ad_server = {
+      id = bpkio_source_adserver.adserver.id
+    }
+    gap_filler = {
+      id = bpkio_source_slate.slate.id
+    }
+  }
+
+  transcoding_profile = {
+    id = 5763
+  }
+
+  enable_ad_transcoding = true
+
+  server_side_ad_tracking = {
+    enable = true
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-virtual-channel-updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_slate.id", "bpkio_source_slate.slate", "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_slate.type", "slate"),
+					resource.TestCheckResourceAttrPair(resourceName, "ad_break_insertion.ad_server.id", "bpkio_source_adserver.adserver", "id"),
+					resource.TestCheckResourceAttr(resourceName, "ad_break_insertion.ad_server.name", "tf-acc-vc-adserver"),
+					resource.TestCheckResourceAttr(resourceName, "ad_break_insertion.gap_filler.type", "slate"),
+					resource.TestCheckResourceAttr(resourceName, "transcoding_profile.id", "5763"),
+					resource.TestCheckResourceAttr(resourceName, "enable_ad_transcoding", "true"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_ad_tracking.enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_ad_tracking.check_ad_media_segment_availability", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the optional blocks goes back to the defaults.
+				Config: testAccServiceVirtualChannelConfig(apiKey, "tf-acc-virtual-channel", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "enabled"),
+					resource.TestCheckNoResourceAttr(resourceName, "default_slate"),
+					resource.TestCheckNoResourceAttr(resourceName, "ad_break_insertion"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_ad_tracking.enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceVirtualChannel_BaseLiveForcesReplacement(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_virtual_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVirtualChannelConfigWithBaseLive(apiKey, "bpkio_source_live.live"),
+			},
+			{
+				Config: testAccServiceVirtualChannelConfigWithBaseLive(apiKey, "bpkio_source_live.other"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPair(resourceName, "base_live.id", "bpkio_source_live.other", "id"),
+			},
+		},
+	})
+}
+
+func TestAccServiceVirtualChannel_InvalidAdServer(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// A slate cannot be used as an ad server.
+				Config: testAccServiceVirtualChannelConfig(apiKey, "tf-acc-virtual-channel", `
+  ad_break_insertion = {
+    ad_server = {
+      id = bpkio_source_slate.slate.id
+    }
+  }
+`),
+				ExpectError: regexp.MustCompile(`(?i)403|forbidden|not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccServiceVirtualChannel_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_service_virtual_channel.test"
+	config := testAccServiceVirtualChannelConfig(apiKey, "tf-acc-virtual-channel", "")
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteService(id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+// testAccServiceVirtualChannelSources declares the sources a virtual channel
+// can reference.
+func testAccServiceVirtualChannelSources(apiKey string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_live" "live" {
+  name = "tf-acc-vc-live"
+  url  = "%s"
+}
+
+resource "bpkio_source_live" "other" {
+  name = "tf-acc-vc-live-other"
+  url  = "%s"
+}
+
+resource "bpkio_source_slate" "slate" {
+  name = "tf-acc-vc-slate"
+  url  = "%s"
+}
+
+resource "bpkio_source_adserver" "adserver" {
+  name = "tf-acc-vc-adserver"
+  url  = "%s"
+}
+`, apiKey, LiveURL, LiveURLOther, SlateURL, AdServerURL)
+}
+
+// testAccServiceVirtualChannelConfig declares a virtual channel on the base
+// live source; extra is added to the body of the service.
+func testAccServiceVirtualChannelConfig(apiKey, name, extra string) string {
+	return testAccServiceVirtualChannelSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_service_virtual_channel" "test" {
+  name = "%s"
+
+  base_live = {
+    id = bpkio_source_live.live.id
+  }
+%s
+}
+`, name, extra)
+}
+
+func testAccServiceVirtualChannelConfigWithBaseLive(apiKey, baseLive string) string {
+	return testAccServiceVirtualChannelSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_service_virtual_channel" "test" {
+  name = "tf-acc-virtual-channel"
+
+  base_live = {
+    id = %s.id
+  }
+}
+`, baseLive)
+}
@@ -6,12 +6,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"time"
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -24,7 +29,7 @@ var (
 
 // servicesDataSource is the data source implementation.
 type servicesDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewServicesDataSource is a helper function to simplify the provider implementation.
@@ -40,11 +45,11 @@ func (d *servicesDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -61,21 +66,57 @@ func (d *servicesDataSource) Metadata(_ context.Context, req datasource.Metadata
 // Schema defines the schema for the data source.
 func (d *servicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Lists the services of the tenant. Filters are combined: a service is listed when it matches all of them.",
 		Attributes: map[string]schema.Attribute{
 			"type": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Only list the services of this type.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("ad-insertion", "content-replacement", "virtual-channel"),
 				},
 			},
 			"state": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Only list the services in this state: `enabled`, `paused` or `bypassed`.",
 				Validators: []validator.String{
-					stringvalidator.OneOf("enabled", "disabled"),
+					stringvalidator.OneOf("enabled", "paused", "bypassed"),
 				},
 			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the services whose name matches this RE2 regular expression.",
+				Validators: []validator.String{
+					isRegexp(),
+				},
+			},
+			"tags_all": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list the services that carry all of these tags.",
+			},
+			"tags_any": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only list the services that carry at least one of these tags.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the services created after this RFC 3339 timestamp.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"updated_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the services last updated after this RFC 3339 timestamp.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"max_results": maxResultsAttribute("services", "in API order"),
 			"services": schema.ListNestedAttribute{
-				Computed: true,
+				Computed:    true,
+				Description: "Services matching the filters.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -116,9 +157,17 @@ func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	services, err := d.client.GetAllServices(0, 2000)
+	filter, diags := expandServicesFilter(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	services, status, err := listAll(ctx, d.client.pageSize, int(state.MaxResults.ValueInt64()), d.client.GetAllServices, filter.matches)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read All Services",
@@ -126,40 +175,117 @@ func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadReques
 		)
 		return
 	}
+	addListWarnings(&resp.Diagnostics, "services", state.MaxResults, status)
 
 	// Map response body to model
+	state.Services = []serviceDataSourceModel{}
 	for _, service := range services {
-		// Convert the []string to types.List
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, service.EnvironmentTags)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
+		serviceState, err := flattenService(service, ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Read All Services", err.Error())
 			return
 		}
+		state.Services = append(state.Services, serviceState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
 
-		serviceState := serviceDataSourceModel{
-			ID:           types.Int64Value(int64(service.Id)),
-			Name:         types.StringValue(service.Name),
-			Type:         types.StringValue(service.Type),
-			URL:          types.StringValue(service.Url),
-			CreationDate: types.StringValue(service.CreationDate), // Make sure these fields exist in your API response
-			UpdateDate:   types.StringValue(service.UpdateDate),
-			State:        types.StringValue(service.State),
-			Tags:         tagsList,
+// servicesFilter selects the services listed by the data source. Zero fields
+// do not filter.
+type servicesFilter struct {
+	Type         string
+	State        string
+	NameRegex    *regexp.Regexp
+	TagsAll      []string
+	TagsAny      []string
+	CreatedAfter time.Time
+	UpdatedAfter time.Time
+}
+
+// expandServicesFilter builds the filter of the data source from its
+// configuration.
+func expandServicesFilter(ctx context.Context, m servicesDataSourceModel) (servicesFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := servicesFilter{
+		Type:  m.Type.ValueString(),
+		State: m.State.ValueString(),
+	}
+
+	if !m.NameRegex.IsNull() {
+		re, err := regexp.Compile(m.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return f, diags
 		}
+		f.NameRegex = re
+	}
 
-		// Filter by type
-		if (state.Type.IsNull() || (service.Type == state.Type.ValueString() && !state.Type.IsNull())) &&
-			(state.State.IsNull() || (service.State == state.State.ValueString() && !state.State.IsNull())) {
-			state.Services = append(state.Services, serviceState)
+	if !m.TagsAll.IsNull() {
+		diags.Append(m.TagsAll.ElementsAs(ctx, &f.TagsAll, false)...)
+	}
+	if !m.TagsAny.IsNull() {
+		diags.Append(m.TagsAny.ElementsAs(ctx, &f.TagsAny, false)...)
+		// An empty tags_any matches nothing rather than everything.
+		if f.TagsAny == nil {
+			f.TagsAny = []string{}
 		}
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	f.CreatedAfter = expandFilterTime(path.Root("created_after"), m.CreatedAfter, &diags)
+	f.UpdatedAfter = expandFilterTime(path.Root("updated_after"), m.UpdatedAfter, &diags)
+
+	return f, diags
+}
+
+// expandFilterTime parses an optional RFC 3339 filter attribute, returning
+// the zero time when it is null.
+func expandFilterTime(p path.Path, v types.String, diags *diag.Diagnostics) time.Time {
+	if v.IsNull() {
+		return time.Time{}
 	}
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Timestamp", err.Error())
+	}
+	return t
+}
+
+// matches reports whether a service passes every filter. Services whose dates
+// cannot be parsed never pass a date filter.
+func (f servicesFilter) matches(s broadpeakio.ServiceOutput) bool {
+	switch {
+	case f.Type != "" && s.Type != f.Type:
+		return false
+	case f.State != "" && s.State != f.State:
+		return false
+	case f.NameRegex != nil && !f.NameRegex.MatchString(s.Name):
+		return false
+	case !f.CreatedAfter.IsZero() && !after(s.CreationDate, f.CreatedAfter):
+		return false
+	case !f.UpdatedAfter.IsZero() && !after(s.UpdateDate, f.UpdatedAfter):
+		return false
+	}
+
+	for _, tag := range f.TagsAll {
+		if !slices.Contains(s.EnvironmentTags, tag) {
+			return false
+		}
+	}
+	if f.TagsAny != nil && !slices.ContainsFunc(f.TagsAny, func(tag string) bool {
+		return slices.Contains(s.EnvironmentTags, tag)
+	}) {
+		return false
+	}
+	return true
+}
+
+// after reports whether the API timestamp date is strictly after t.
+func after(date string, t time.Time) bool {
+	d, err := time.Parse(time.RFC3339, date)
+	return err == nil && d.After(t)
 }
 
 func flattenService(s broadpeakio.ServiceOutput, ctx context.Context) (serviceDataSourceModel, error) {
@@ -181,9 +307,15 @@ func flattenService(s broadpeakio.ServiceOutput, ctx context.Context) (serviceDa
 
 // servicesDataSourceModel maps the data source schema data.
 type servicesDataSourceModel struct {
-	Type     types.String             `tfsdk:"type"`
-	State    types.String             `tfsdk:"state"`
-	Services []serviceDataSourceModel `tfsdk:"services"`
+	Type         types.String             `tfsdk:"type"`
+	State        types.String             `tfsdk:"state"`
+	NameRegex    types.String             `tfsdk:"name_regex"`
+	TagsAll      types.Set                `tfsdk:"tags_all"`
+	TagsAny      types.Set                `tfsdk:"tags_any"`
+	CreatedAfter types.String             `tfsdk:"created_after"`
+	UpdatedAfter types.String             `tfsdk:"updated_after"`
+	MaxResults   types.Int64              `tfsdk:"max_results"`
+	Services     []serviceDataSourceModel `tfsdk:"services"`
 }
 
 // serviceModel maps service schema data.
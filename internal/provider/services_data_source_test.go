@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"regexp"
 	"testing"
+	"time"
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,3 +84,109 @@ func TestFlattenService(t *testing.T) {
 		})
 	}
 }
+
+func TestServicesFilterMatches(t *testing.T) {
+	svc := broadpeakio.ServiceOutput{
+		Id:              42,
+		Name:            "prod-news",
+		Type:            "ad-insertion",
+		State:           "paused",
+		CreationDate:    "2024-03-01T10:00:00.000Z",
+		UpdateDate:      "2024-06-01T10:00:00.000Z",
+		EnvironmentTags: []string{"prod", "eu"},
+	}
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter servicesFilter
+		want   bool
+	}{
+		{"no filter", servicesFilter{}, true},
+		{"state", servicesFilter{State: "paused"}, true},
+		{"other state", servicesFilter{State: "enabled"}, false},
+		{"name regex", servicesFilter{NameRegex: regexp.MustCompile(`^prod-`)}, true},
+		{"name regex mismatch", servicesFilter{NameRegex: regexp.MustCompile(`^dev-`)}, false},
+		{"tags all", servicesFilter{TagsAll: []string{"prod", "eu"}}, true},
+		{"tags all missing one", servicesFilter{TagsAll: []string{"prod", "us"}}, false},
+		{"tags any", servicesFilter{TagsAny: []string{"us", "eu"}}, true},
+		{"tags any none", servicesFilter{TagsAny: []string{"us"}}, false},
+		{"tags any empty", servicesFilter{TagsAny: []string{}}, false},
+		{"created after", servicesFilter{CreatedAfter: march}, true},
+		{"created after is strict", servicesFilter{CreatedAfter: march.Add(10 * time.Hour)}, false},
+		{"updated after", servicesFilter{UpdatedAfter: march.AddDate(0, 6, 0)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.filter.matches(svc))
+		})
+	}
+}
+
+func TestAccServicesDataSource_Filters(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesFilterConfig(apiKey) + `
+data "bpkio_services" "paused_prod" {
+  name_regex = "^tf-acc-services-"
+  state      = "paused"
+  tags_all   = ["prod"]
+
+  depends_on = [bpkio_service_virtual_channel.prod, bpkio_service_virtual_channel.staging]
+}
+
+data "bpkio_services" "any" {
+  name_regex    = "^tf-acc-services-"
+  tags_any      = ["prod", "staging"]
+  created_after = "2000-01-01T00:00:00Z"
+
+  depends_on = [bpkio_service_virtual_channel.prod, bpkio_service_virtual_channel.staging]
+}
+
+data "bpkio_services" "future" {
+  name_regex    = "^tf-acc-services-"
+  updated_after = "2999-01-01T00:00:00Z"
+
+  depends_on = [bpkio_service_virtual_channel.prod, bpkio_service_virtual_channel.staging]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bpkio_services.paused_prod", "services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bpkio_services.paused_prod", "services.0.id", "bpkio_service_virtual_channel.prod", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_services.any", "services.#", "2"),
+					resource.TestCheckResourceAttr("data.bpkio_services.future", "services.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicesFilterConfig(apiKey string) string {
+	return testAccServiceVirtualChannelSources(apiKey) + `
+resource "bpkio_service_virtual_channel" "prod" {
+  name  = "tf-acc-services-prod"
+  state = "paused"
+  tags  = ["prod", "eu"]
+
+  base_live = {
+    id = bpkio_source_live.live.id
+  }
+}
+
+resource "bpkio_service_virtual_channel" "staging" {
+  name  = "tf-acc-services-staging"
+  state = "enabled"
+  tags  = ["staging"]
+
+  base_live = {
+    id = bpkio_source_live.other.id
+  }
+}
+`
+}
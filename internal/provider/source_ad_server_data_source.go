@@ -24,7 +24,7 @@ var (
 
 // sourceAdServerDataSource is the data source implementation.
 type sourceAdServerDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewSourceAdServerDataSource is a helper function to simplify the provider implementation.
@@ -40,11 +40,11 @@ func (d *sourceAdServerDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -62,12 +62,8 @@ func (d *sourceAdServerDataSource) Metadata(_ context.Context, req datasource.Me
 func (d *sourceAdServerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Computed: true,
-			},
+			"id":   lookupIDAttribute("ad server"),
+			"name": lookupNameAttribute("ad server"),
 			"type": schema.StringAttribute{
 				Computed: true,
 			},
@@ -106,10 +102,17 @@ func (d *sourceAdServerDataSource) Read(
 	resp *datasource.ReadResponse,
 ) {
 	//--------------------------------------------------------------------
-	// 1. Parse the ID from configuration
+	// 1. Resolve the ID from configuration
 	//--------------------------------------------------------------------
-	var adServerID int64
-	diags := req.Config.GetAttribute(ctx, path.Root("id"), &adServerID)
+	var id types.Int64
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	adServerID, diags := lookupID(ctx, id, name, "ad server", d.client.namedSources("ad-server"))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -118,7 +121,7 @@ func (d *sourceAdServerDataSource) Read(
 	//--------------------------------------------------------------------
 	// 2. Call the Broadpeak API
 	//--------------------------------------------------------------------
-	src, err := d.client.GetAdServer(uint(adServerID))
+	src, err := d.client.GetAdServer(ctx, uint(adServerID))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Source Ad-Server",
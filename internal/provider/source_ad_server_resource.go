@@ -10,6 +10,7 @@ import (
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -37,7 +39,17 @@ func NewSourceAdServerResource() resource.Resource {
 
 // sourceAdServerResource is the resource implementation.
 type sourceAdServerResource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
+}
+
+// sourceAdServerAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var sourceAdServerAPIFields = map[string]path.Path{
+	"name":            path.Root("name"),
+	"url":             path.Root("url"),
+	"description":     path.Root("description"),
+	"queries":         path.Root("queries"),
+	"queryParameters": path.Root("query_parameters"),
 }
 
 // Configure adds the provider configured client to the resource.
@@ -47,11 +59,11 @@ func (r *sourceAdServerResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -66,7 +78,7 @@ func (r *sourceAdServerResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *sourceAdServerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sourceAdServerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -129,9 +141,19 @@ func (r *sourceAdServerResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+// sourceAdServerResourceModel is the state of an ad server resource: the data
+// source model plus the operation timeouts.
+type sourceAdServerResourceModel struct {
+	sourceAdServerDataSourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *sourceAdServerResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -140,13 +162,19 @@ func (r *sourceAdServerResource) Create(
 	//--------------------------------------------------------------------
 	// 1. Decode the plan into a strongly-typed model
 	//--------------------------------------------------------------------
-	var plan sourceAdServerDataSourceModel
+	var plan sourceAdServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//--------------------------------------------------------------------
 	// 2. Build the Broadpeak API input
 	//--------------------------------------------------------------------
@@ -178,12 +206,9 @@ func (r *sourceAdServerResource) Create(
 	//--------------------------------------------------------------------
 	// 3. Call Broadpeak to create the Ad-Server
 	//--------------------------------------------------------------------
-	created, err := r.client.CreateAdServer(adInput)
+	created, err := r.client.CreateAdServer(ctx, adInput)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Ad-Server",
-			fmt.Sprintf("Could not create Ad-Server: %s", err),
-		)
+		addAPIError(&resp.Diagnostics, "Error Creating Ad-Server", "Could not create Ad-Server", err, sourceAdServerAPIFields)
 		return
 	}
 
@@ -236,7 +261,7 @@ func (r *sourceAdServerResource) Create(
 	//--------------------------------------------------------------------
 	// 5. Save state
 	//--------------------------------------------------------------------
-	diags = resp.State.Set(ctx, newState)
+	diags = resp.State.Set(ctx, sourceAdServerResourceModel{newState, plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -249,7 +274,7 @@ func (r *sourceAdServerResource) Read(
 	//--------------------------------------------------------------------
 	// 1. Load the prior state (contains the ID)
 	//--------------------------------------------------------------------
-	var state sourceAdServerDataSourceModel
+	var state sourceAdServerResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -259,11 +284,16 @@ func (r *sourceAdServerResource) Read(
 	//--------------------------------------------------------------------
 	// 2. Query Broadpeak for the latest object
 	//--------------------------------------------------------------------
-	src, err := r.client.GetAdServer(uint(state.ID.ValueInt64()))
+	src, err := r.client.GetAdServer(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Source ad-server no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Source Ad-Server",
-			fmt.Sprintf("Ad-Server with ID %d not found (%s)", state.ID.ValueInt64(), err),
+			fmt.Sprintf("Could not read ad-server ID %d: %s", state.ID.ValueInt64(), err),
 		)
 		return
 	}
@@ -319,7 +349,7 @@ func (r *sourceAdServerResource) Read(
 	//--------------------------------------------------------------------
 	// 5. Save state
 	//--------------------------------------------------------------------
-	diags = resp.State.Set(ctx, newState)
+	diags = resp.State.Set(ctx, sourceAdServerResourceModel{newState, state.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -331,13 +361,19 @@ func (r *sourceAdServerResource) Update(
 	//--------------------------------------------------------------------
 	// 1. Decode the planned values
 	//--------------------------------------------------------------------
-	var plan sourceAdServerDataSourceModel
+	var plan sourceAdServerResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//--------------------------------------------------------------------
 	// 2. Build the Broadpeak input
 	//--------------------------------------------------------------------
@@ -370,18 +406,15 @@ func (r *sourceAdServerResource) Update(
 	// 3. Call the Broadpeak API
 	//--------------------------------------------------------------------
 	adID := uint(plan.ID.ValueInt64())
-	if _, err := r.client.UpdateAdServer(adID, updInput); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Ad-Server",
-			fmt.Sprintf("Could not update ad-server ID %d: %s", adID, err),
-		)
+	if _, err := r.client.UpdateAdServer(ctx, adID, updInput); err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Ad-Server", fmt.Sprintf("Could not update ad-server ID %d", adID), err, sourceAdServerAPIFields)
 		return
 	}
 
 	//--------------------------------------------------------------------
 	// 4. Re-query to obtain the authoritative object
 	//--------------------------------------------------------------------
-	src, err := r.client.GetAdServer(adID)
+	src, err := r.client.GetAdServer(ctx, adID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Updated Ad-Server",
@@ -438,23 +471,30 @@ func (r *sourceAdServerResource) Update(
 		QueryParameters: paramsList,
 	}
 
-	diags = resp.State.Set(ctx, newState)
+	diags = resp.State.Set(ctx, sourceAdServerResourceModel{newState, plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *sourceAdServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state sourceAdServerDataSourceModel
+	var state sourceAdServerResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing adserver
-	_, err := r.client.DeleteAdServer(uint(state.ID.ValueInt64()))
-	if err != nil {
+	_, err := r.client.DeleteAdServer(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Source AdServer",
 			"Could not delete adserver, unexpected error: "+err.Error(),
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"strings"
 	"testing"
@@ -14,10 +13,7 @@ import (
 var adServerURL = "https://vast-prep.staging.olyzon.tv/sources/1042586b/serve"
 
 func TestAccSourceAdServer_Basic(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_adserver.test"
 
 	// This URL MUST point to a real, working adserver in your environment!
@@ -75,7 +71,7 @@ resource "bpkio_source_adserver" "test" {
 }
 
 func TestAccSourceAdServer_ComputedFields(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_adserver.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -93,7 +89,7 @@ func TestAccSourceAdServer_ComputedFields(t *testing.T) {
 }
 
 func TestAccSourceAdServer_MinimalConfig(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_adserver.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -111,7 +107,7 @@ func TestAccSourceAdServer_MinimalConfig(t *testing.T) {
 }
 
 func TestAccSourceAdServer_MissingName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	config := fmt.Sprintf(`
 provider "bpkio" {
   api_key = "%s"
@@ -134,7 +130,7 @@ resource "bpkio_source_adserver" "test" {
 }
 
 func TestAccSourceAdServer_LongSpecialName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	longName := strings.Repeat("x", 101) // >100 chars to trigger validation error
 	config := fmt.Sprintf(`
 provider "bpkio" {
@@ -159,7 +155,7 @@ resource "bpkio_source_adserver" "test" {
 }
 
 func TestAccSourceAdServer_DuplicateNameURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	unique := "tf-acc-dupe"
 	dupeConfig := fmt.Sprintf(`
 provider "bpkio" {
@@ -187,7 +183,7 @@ resource "bpkio_source_adserver" "second" {
 }
 
 func TestAccSourceAdServer_QueryParameters(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_adserver.test"
 	config := fmt.Sprintf(`
 provider "bpkio" {
@@ -225,7 +221,7 @@ resource "bpkio_source_adserver" "test" {
 }
 
 func TestAccSourceAdServer_InvalidURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
+	apiKey := testAccAPIKey()
 	badURL := "https://this-url-will-not-exist.example.com"
 	config := fmt.Sprintf(`
 provider "bpkio" {
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sourceAssetCatalogDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourceAssetCatalogDataSource{}
+)
+
+// sourceAssetCatalogDataSource is the data source implementation.
+type sourceAssetCatalogDataSource struct {
+	client *bpkioClient
+}
+
+// NewSourceAssetCatalogDataSource is a helper function to simplify the provider implementation.
+func NewSourceAssetCatalogDataSource() datasource.DataSource {
+	return &sourceAssetCatalogDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sourceAssetCatalogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *sourceAssetCatalogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_asset_catalog"
+}
+
+// Schema defines the schema for the data source.
+func (d *sourceAssetCatalogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns a VOD asset catalog source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the asset catalog.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the asset catalog.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The base URL of the catalog.",
+			},
+			"asset_sample": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path, relative to `url`, of the asset used to validate the catalog.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "A description of the asset catalog.",
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: "The format of the catalog assets, as detected by the API.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sourceAssetCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sourceAssetCatalogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := d.client.GetAssetCatalog(ctx, uint(config.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Asset Catalog",
+			fmt.Sprintf("Could not read asset catalog ID %d: %s", config.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set state
+	state := flattenSourceAssetCatalog(catalog)
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func flattenSourceAssetCatalog(catalog assetCatalogOutput) sourceAssetCatalogModel {
+	return sourceAssetCatalogModel{
+		ID:          types.Int64Value(int64(catalog.Id)),
+		Name:        types.StringValue(catalog.Name),
+		URL:         types.StringValue(catalog.Url),
+		AssetSample: types.StringValue(catalog.AssetSample),
+		Description: types.StringValue(catalog.Description),
+		Format:      types.StringValue(catalog.Format),
+	}
+}
+
+// sourceAssetCatalogModel maps the asset catalog schema data, shared by the resource and
+// the data source.
+type sourceAssetCatalogModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	AssetSample types.String `tfsdk:"asset_sample"`
+	Description types.String `tfsdk:"description"`
+	Format      types.String `tfsdk:"format"`
+}
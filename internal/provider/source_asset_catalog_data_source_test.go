@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSourceAssetCatalog(t *testing.T) {
+	input := assetCatalogOutput{
+		AssetCatalogOutput: broadpeakio.AssetCatalogOutput{
+			Id:          42,
+			Name:        "VOD library",
+			Url:         "https://cdn.example/vod/",
+			AssetSample: "bbb/bbb.m3u8",
+			Description: "All movies",
+		},
+		Format: "hls",
+	}
+
+	expected := sourceAssetCatalogModel{
+		ID:          types.Int64Value(42),
+		Name:        types.StringValue("VOD library"),
+		URL:         types.StringValue("https://cdn.example/vod/"),
+		AssetSample: types.StringValue("bbb/bbb.m3u8"),
+		Description: types.StringValue("All movies"),
+		Format:      types.StringValue("hls"),
+	}
+
+	require.Equal(t, expected, flattenSourceAssetCatalog(input))
+}
+
+func TestAccSourceAssetCatalogDataSource_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_asset_catalog" "test" {
+  name         = "tf-acc-test-catalog-ds"
+  url          = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/"
+  asset_sample = "bbb/bbb.m3u8"
+}
+
+data "bpkio_source_asset_catalog" "test" {
+  id = bpkio_source_asset_catalog.test.id
+}
+`, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bpkio_source_asset_catalog.test", "id", "bpkio_source_asset_catalog.test", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_source_asset_catalog.test", "name", "tf-acc-test-catalog-ds"),
+					resource.TestCheckResourceAttr("data.bpkio_source_asset_catalog.test", "asset_sample", "bbb/bbb.m3u8"),
+					resource.TestCheckResourceAttrPair("data.bpkio_source_asset_catalog.test", "format", "bpkio_source_asset_catalog.test", "format"),
+				),
+			},
+		},
+	})
+}
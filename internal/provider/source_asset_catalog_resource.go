@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sourceAssetCatalogResource{}
+	_ resource.ResourceWithConfigure   = &sourceAssetCatalogResource{}
+	_ resource.ResourceWithImportState = &sourceAssetCatalogResource{}
+)
+
+// NewSourceAssetCatalogResource is a helper function to simplify the provider implementation.
+func NewSourceAssetCatalogResource() resource.Resource {
+	return &sourceAssetCatalogResource{}
+}
+
+// sourceAssetCatalogResource is the resource implementation.
+type sourceAssetCatalogResource struct {
+	client *bpkioClient
+}
+
+// sourceAssetCatalogAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var sourceAssetCatalogAPIFields = map[string]path.Path{
+	"name":        path.Root("name"),
+	"url":         path.Root("url"),
+	"description": path.Root("description"),
+	"assetSample": path.Root("asset_sample"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceAssetCatalogResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *sourceAssetCatalogResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_asset_catalog"
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceAssetCatalogResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VOD asset catalog source, a folder of on-demand assets served from a common base URL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the asset catalog.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the asset catalog.",
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "The base URL of the catalog. Assets are requested at paths relative to it.",
+			},
+			"asset_sample": schema.StringAttribute{
+				Required:    true,
+				Description: "The path, relative to `url`, of an asset of the catalog. The API fetches it to validate the catalog and detect its format.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description of the asset catalog.",
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: "The format of the catalog assets, as detected by the API from `asset_sample`. Refreshed on every read.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// sourceAssetCatalogResourceModel is the state of an asset catalog resource:
+// the asset catalog plus the operation timeouts.
+type sourceAssetCatalogResourceModel struct {
+	sourceAssetCatalogModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sourceAssetCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan sourceAssetCatalogResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new asset catalog
+	catalog, err := r.client.CreateAssetCatalog(ctx, expandSourceAssetCatalog(plan.sourceAssetCatalogModel))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating asset catalog", "Could not create asset catalog", err, sourceAssetCatalogAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := sourceAssetCatalogResourceModel{flattenSourceAssetCatalog(catalog), plan.Timeouts}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sourceAssetCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state sourceAssetCatalogResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := r.client.GetAssetCatalog(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Source asset catalog no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Asset Catalog",
+			fmt.Sprintf("Could not read asset catalog ID %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state.sourceAssetCatalogModel = flattenSourceAssetCatalog(catalog)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sourceAssetCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan sourceAssetCatalogResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogID := uint(plan.ID.ValueInt64())
+
+	// Update existing asset catalog
+	if _, err := r.client.UpdateAssetCatalog(ctx, catalogID, expandSourceAssetCatalog(plan.sourceAssetCatalogModel)); err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating asset catalog", fmt.Sprintf("Could not update asset catalog ID %d", catalogID), err, sourceAssetCatalogAPIFields)
+		return
+	}
+
+	// Fetch the catalog again, so that computed attributes reflect the update
+	catalog, err := r.client.GetAssetCatalog(ctx, catalogID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Asset Catalog",
+			fmt.Sprintf("Could not fetch asset catalog ID %d after update: %s", catalogID, err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	state := sourceAssetCatalogResourceModel{flattenSourceAssetCatalog(catalog), plan.Timeouts}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sourceAssetCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state sourceAssetCatalogResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing asset catalog
+	_, err := r.client.DeleteAssetCatalog(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Source Asset Catalog",
+			"Could not delete asset catalog, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *sourceAssetCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing source asset catalog",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandSourceAssetCatalog builds the API request for an asset catalog from
+// its model.
+func expandSourceAssetCatalog(m sourceAssetCatalogModel) broadpeakio.AssetCatalogInput {
+	return broadpeakio.AssetCatalogInput{
+		Name:        m.Name.ValueString(),
+		Url:         m.URL.ValueString(),
+		Description: m.Description.ValueString(),
+		AssetSample: m.AssetSample.ValueString(),
+	}
+}
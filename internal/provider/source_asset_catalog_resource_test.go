@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourceAssetCatalog_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_asset_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAssetCatalogConfig(apiKey, "tf-acc-test-catalog", "bbb/bbb.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-test-catalog"),
+					resource.TestCheckResourceAttr(resourceName, "asset_sample", "bbb/bbb.m3u8"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "format"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceAssetCatalogConfig(apiKey, "tf-acc-test-catalog-renamed", "bbb/bbb.mpd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-test-catalog-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "asset_sample", "bbb/bbb.mpd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceAssetCatalog_FormatRefreshed(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_asset_catalog.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAssetCatalogConfig(apiKey, "tf-acc-test-catalog", "bbb/bbb.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCaptureID(resourceName, &id),
+					resource.TestCheckResourceAttr(resourceName, "format", "hls"),
+				),
+			},
+			{
+				// The API re-detects the format; a refresh picks it up without
+				// planning any change.
+				PreConfig: func() {
+					testAccFakeAPI.SetSourceField(id, "format", "dash")
+				},
+				RefreshState: true,
+				Check:        resource.TestCheckResourceAttr(resourceName, "format", "dash"),
+			},
+			{
+				Config:   testAccSourceAssetCatalogConfig(apiKey, "tf-acc-test-catalog", "bbb/bbb.m3u8"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSourceAssetCatalog_MissingAssetSample(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_asset_catalog" "test" {
+  name = "tf-acc-test-catalog"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/"
+}
+`, apiKey),
+				ExpectError: regexp.MustCompile(`The argument "asset_sample" is required`),
+			},
+		},
+	})
+}
+
+func testAccSourceAssetCatalogConfig(apiKey, name, assetSample string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_asset_catalog" "test" {
+  name         = "%s"
+  url          = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/"
+  asset_sample = "%s"
+}
+`, apiKey, name, assetSample)
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sourceAssetDataSource{}
+	_ datasource.DataSourceWithConfigure = &sourceAssetDataSource{}
+)
+
+// sourceAssetDataSource is the data source implementation.
+type sourceAssetDataSource struct {
+	client *bpkioClient
+}
+
+// NewSourceAssetDataSource is a helper function to simplify the provider implementation.
+func NewSourceAssetDataSource() datasource.DataSource {
+	return &sourceAssetDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sourceAssetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *sourceAssetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_asset"
+}
+
+// Schema defines the schema for the data source.
+func (d *sourceAssetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns a VOD asset source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the asset.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the asset.",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the asset.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "A description of the asset.",
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: "The format of the asset, as detected by the API.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sourceAssetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config sourceAssetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, err := d.client.GetAsset(ctx, uint(config.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Asset",
+			fmt.Sprintf("Could not read asset ID %d: %s", config.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set state
+	state := flattenSourceAsset(asset)
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func flattenSourceAsset(asset broadpeakio.AssetOutput) sourceAssetModel {
+	return sourceAssetModel{
+		ID:          types.Int64Value(int64(asset.Id)),
+		Name:        types.StringValue(asset.Name),
+		URL:         types.StringValue(asset.Url),
+		Description: types.StringValue(asset.Description),
+		Format:      types.StringValue(asset.Format),
+	}
+}
+
+// sourceAssetModel maps the asset schema data, shared by the resource and
+// the data source.
+type sourceAssetModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	Format      types.String `tfsdk:"format"`
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenSourceAsset(t *testing.T) {
+	input := broadpeakio.AssetOutput{
+		Id:          42,
+		Name:        "Big Buck Bunny",
+		Url:         "https://cdn.example/bbb.m3u8",
+		Description: "Open movie",
+		Format:      "hls",
+	}
+
+	expected := sourceAssetModel{
+		ID:          types.Int64Value(42),
+		Name:        types.StringValue("Big Buck Bunny"),
+		URL:         types.StringValue("https://cdn.example/bbb.m3u8"),
+		Description: types.StringValue("Open movie"),
+		Format:      types.StringValue("hls"),
+	}
+
+	require.Equal(t, expected, flattenSourceAsset(input))
+}
+
+func TestAccSourceAssetDataSource_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_asset" "test" {
+  name        = "tf-acc-test-asset-ds"
+  url         = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8"
+  description = "asset read back by the data source"
+}
+
+data "bpkio_source_asset" "test" {
+  id = bpkio_source_asset.test.id
+}
+`, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bpkio_source_asset.test", "id", "bpkio_source_asset.test", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_source_asset.test", "name", "tf-acc-test-asset-ds"),
+					resource.TestCheckResourceAttr("data.bpkio_source_asset.test", "description", "asset read back by the data source"),
+					resource.TestCheckResourceAttrPair("data.bpkio_source_asset.test", "format", "bpkio_source_asset.test", "format"),
+				),
+			},
+		},
+	})
+}
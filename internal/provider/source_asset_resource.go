@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sourceAssetResource{}
+	_ resource.ResourceWithConfigure   = &sourceAssetResource{}
+	_ resource.ResourceWithImportState = &sourceAssetResource{}
+)
+
+// NewSourceAssetResource is a helper function to simplify the provider implementation.
+func NewSourceAssetResource() resource.Resource {
+	return &sourceAssetResource{}
+}
+
+// sourceAssetResource is the resource implementation.
+type sourceAssetResource struct {
+	client *bpkioClient
+}
+
+// sourceAssetAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var sourceAssetAPIFields = map[string]path.Path{
+	"name":        path.Root("name"),
+	"url":         path.Root("url"),
+	"description": path.Root("description"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sourceAssetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *sourceAssetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_asset"
+}
+
+// Schema defines the schema for the resource.
+func (r *sourceAssetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a VOD asset source, a single on-demand stream or file.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the asset.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the asset.",
+			},
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL of the asset.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description of the asset.",
+			},
+			"format": schema.StringAttribute{
+				Computed:    true,
+				Description: "The format of the asset, as detected by the API, e.g. `hls`, `dash` or `mp4`.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// sourceAssetResourceModel is the state of an asset resource: the asset
+// plus the operation timeouts.
+type sourceAssetResourceModel struct {
+	sourceAssetModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sourceAssetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan sourceAssetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new asset
+	asset, err := r.client.CreateAsset(ctx, expandSourceAsset(plan.sourceAssetModel))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating asset", "Could not create asset", err, sourceAssetAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := sourceAssetResourceModel{flattenSourceAsset(asset), plan.Timeouts}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sourceAssetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state sourceAssetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asset, err := r.client.GetAsset(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Source asset no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Source Asset",
+			fmt.Sprintf("Could not read asset ID %d: %s", state.ID.ValueInt64(), err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state.sourceAssetModel = flattenSourceAsset(asset)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sourceAssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan sourceAssetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetID := uint(plan.ID.ValueInt64())
+
+	// Update existing asset
+	if _, err := r.client.UpdateAsset(ctx, assetID, expandSourceAsset(plan.sourceAssetModel)); err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating asset", fmt.Sprintf("Could not update asset ID %d", assetID), err, sourceAssetAPIFields)
+		return
+	}
+
+	// Fetch the asset again, so that computed attributes reflect the update
+	asset, err := r.client.GetAsset(ctx, assetID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Asset",
+			fmt.Sprintf("Could not fetch asset ID %d after update: %s", assetID, err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	state := sourceAssetResourceModel{flattenSourceAsset(asset), plan.Timeouts}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sourceAssetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state sourceAssetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing asset
+	_, err := r.client.DeleteAsset(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Source Asset",
+			"Could not delete asset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *sourceAssetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing source asset",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandSourceAsset builds the API request for an asset from its model.
+func expandSourceAsset(m sourceAssetModel) broadpeakio.AssetInput {
+	return broadpeakio.AssetInput{
+		Name:        m.Name.ValueString(),
+		Url:         m.URL.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
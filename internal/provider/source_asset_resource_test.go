@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourceAsset_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_asset.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-test-asset"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "format"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceAssetConfig(apiKey, "tf-acc-test-asset-renamed", "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.mpd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-test-asset-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.mpd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceAsset_Format(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_asset.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "https://vod.example.com/movie.m3u8"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "format", "hls"),
+			},
+			{
+				// The format is detected again when the URL changes.
+				Config: testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "https://vod.example.com/movie.mpd"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "format", "dash"),
+			},
+		},
+	})
+}
+
+func TestAccSourceAsset_InvalidURL(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "not-a-url"),
+				ExpectError: regexp.MustCompile(`url must be a URL address`),
+			},
+		},
+	})
+}
+
+func TestAccSourceAsset_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_asset.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "https://vod.example.com/movie.m3u8"),
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteSource(id)
+				},
+				Config:             testAccSourceAssetConfig(apiKey, "tf-acc-test-asset", "https://vod.example.com/movie.m3u8"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSourceAssetConfig(apiKey, name, url string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_asset" "test" {
+  name = "%s"
+  url  = "%s"
+}
+`, apiKey, name, url)
+}
@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	broadpeakio "github.com/bashou/bpkio-go-sdk"
-
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -25,7 +23,7 @@ var (
 
 // sourceLiveDataSource is the data source implementation.
 type sourceLiveDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewSourceLiveDataSource is a helper function to simplify the provider implementation.
@@ -41,11 +39,11 @@ func (d *sourceLiveDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -63,12 +61,8 @@ func (d *sourceLiveDataSource) Metadata(_ context.Context, req datasource.Metada
 func (d *sourceLiveDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Computed: true,
-			},
+			"id":   lookupIDAttribute("live source"),
+			"name": lookupNameAttribute("live source"),
 			"type": schema.StringAttribute{
 				Computed: true,
 			},
@@ -109,11 +103,16 @@ func (d *sourceLiveDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 // Read refreshes the Terraform state with the latest data.
 func (d *sourceLiveDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state sourceLiveDataSourceModel
-	var sourceid int64
+	var id types.Int64
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags := req.Config.GetAttribute(ctx, path.Root("id"), &sourceid)
+	sourceid, diags := lookupID(ctx, id, name, "live source", d.client.namedSources("live"))
 	resp.Diagnostics.Append(diags...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -122,7 +121,7 @@ func (d *sourceLiveDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
 	// Get the source from the API
-	source, err := d.client.GetLive(uint(sourceid))
+	source, err := d.client.GetLive(ctx, uint(sourceid))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Single Service",
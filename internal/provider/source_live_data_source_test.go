@@ -4,56 +4,109 @@
 package provider
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestFlattenSources(t *testing.T) {
+	source := func(id uint, name, sourceType, url, format string) sourceListOutput {
+		return sourceListOutput{SourceOutput: broadpeakio.SourceOutput{Id: id, Name: name, Type: sourceType, Url: url, Format: format}}
+	}
+	model := func(id int64, name, sourceType, url, format string) sourcesModel {
+		return sourcesModel{
+			ID:          types.Int64Value(id),
+			Name:        types.StringValue(name),
+			Type:        types.StringValue(sourceType),
+			URL:         types.StringValue(url),
+			Description: types.StringValue(""),
+			Format:      types.StringValue(format),
+			MultiPeriod: types.BoolValue(false),
+		}
+	}
+
+	input := []sourceListOutput{
+		source(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
+		source(1, "Asset1", "asset", "http://asset1/index.mpd", "dash"),
+		source(2, "Live2", "live", "http://live2/index.mpd", "dash"),
+	}
+
 	testCases := []struct {
-		name       string
-		filterType *string
-		input      []broadpeakio.Source
-		expected   []sourcesModel
+		name     string
+		input    []sourceListOutput
+		filter   sourcesFilter
+		expected []sourcesModel
 	}{
 		{
-			name:       "no filter, multiple types",
-			filterType: nil,
-			input: []broadpeakio.Source{
-				{Id: 1, Name: "Live1", Type: "live", Url: "http://live1"},
-				{Id: 2, Name: "Asset1", Type: "asset", Url: "http://asset1"},
+			name:   "no filter, multiple types",
+			input:  input,
+			filter: sourcesFilter{},
+			expected: []sourcesModel{
+				model(1, "Asset1", "asset", "http://asset1/index.mpd", "dash"),
+				model(2, "Live2", "live", "http://live2/index.mpd", "dash"),
+				model(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
 			},
+		},
+		{
+			name:   "filter live only",
+			input:  input,
+			filter: sourcesFilter{Type: "live"},
 			expected: []sourcesModel{
-				{ID: types.Int64Value(1), Name: types.StringValue("Live1"), Type: types.StringValue("live"), URL: types.StringValue("http://live1")},
-				{ID: types.Int64Value(2), Name: types.StringValue("Asset1"), Type: types.StringValue("asset"), URL: types.StringValue("http://asset1")},
+				model(2, "Live2", "live", "http://live2/index.mpd", "dash"),
+				model(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
 			},
 		},
 		{
-			name:       "filter live only",
-			filterType: ptr("live"),
-			input: []broadpeakio.Source{
-				{Id: 1, Name: "Live1", Type: "live", Url: "http://live1"},
-				{Id: 2, Name: "Asset1", Type: "asset", Url: "http://asset1"},
+			name:     "filter matches nothing",
+			input:    input,
+			filter:   sourcesFilter{Type: "ad-server"},
+			expected: []sourcesModel{},
+		},
+		{
+			name:   "name regex and format",
+			input:  input,
+			filter: sourcesFilter{NameRegex: regexp.MustCompile(`^Live`), Format: "DASH"},
+			expected: []sourcesModel{
+				model(2, "Live2", "live", "http://live2/index.mpd", "dash"),
 			},
+		},
+		{
+			name:   "url contains",
+			input:  input,
+			filter: sourcesFilter{URLContains: "live1"},
 			expected: []sourcesModel{
-				{ID: types.Int64Value(1), Name: types.StringValue("Live1"), Type: types.StringValue("live"), URL: types.StringValue("http://live1")},
+				model(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
 			},
 		},
 		{
-			name:       "filter matches nothing",
-			filterType: ptr("ad-server"),
-			input: []broadpeakio.Source{
-				{Id: 1, Name: "Live1", Type: "live", Url: "http://live1"},
+			name:   "ids, sorted by name",
+			input:  input,
+			filter: sourcesFilter{IDs: map[uint]bool{1: true, 3: true}, SortBy: "name"},
+			expected: []sourcesModel{
+				model(1, "Asset1", "asset", "http://asset1/index.mpd", "dash"),
+				model(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
+			},
+		},
+		{
+			name:   "sorted by type, ties by id",
+			input:  input,
+			filter: sourcesFilter{SortBy: "type"},
+			expected: []sourcesModel{
+				model(1, "Asset1", "asset", "http://asset1/index.mpd", "dash"),
+				model(2, "Live2", "live", "http://live2/index.mpd", "dash"),
+				model(3, "Live1", "live", "http://live1/master.m3u8", "hls"),
 			},
-			expected: []sourcesModel{},
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := flattenSources(tc.input, tc.filterType)
+			got := flattenSources(tc.input, tc.filter)
 			if !reflect.DeepEqual(got, tc.expected) {
 				t.Errorf("expected:\n%#v\ngot:\n%#v", tc.expected, got)
 			}
@@ -61,7 +114,63 @@ func TestFlattenSources(t *testing.T) {
 	}
 }
 
-// tiny helper
-func ptr[T any](v T) *T {
-	return &v
+func TestAccSourceLiveDataSource_Name(t *testing.T) {
+	apiKey := testAccAPIKey()
+	dataSourceName := "data.bpkio_source_live.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+data "bpkio_source_live" "test" {
+  id   = 1
+  name = "tf-acc-test-live"
+}
+`, apiKey),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccSourceLiveConfig(apiKey) + `
+data "bpkio_source_live" "test" {
+  name = bpkio_source_live.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "bpkio_source_live.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "url", "bpkio_source_live.test", "url"),
+				),
+			},
+			{
+				Config: testAccSourceLiveConfig(apiKey) + `
+data "bpkio_source_live" "test" {
+  name = "tf-acc-test-live-missing"
+
+  depends_on = [bpkio_source_live.test]
+}
+`,
+				ExpectError: regexp.MustCompile(`No live source is named "tf-acc-test-live-missing"`),
+			},
+			{
+				Config: testAccSourceLiveConfig(apiKey) + `
+resource "bpkio_source_live" "other" {
+  name = bpkio_source_live.test.name
+  url  = "https://hls-radio-s3.nextradiotv.com/olyzon/delayed/master.m3u8?copy=1"
+}
+
+data "bpkio_source_live" "test" {
+  name = bpkio_source_live.test.name
+
+  depends_on = [bpkio_source_live.other]
+}
+`,
+				ExpectError: regexp.MustCompile(`2 live sources are named "tf-acc-test-live"`),
+			},
+		},
+	})
 }
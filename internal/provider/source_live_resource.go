@@ -10,6 +10,7 @@ import (
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -38,7 +40,18 @@ func NewSourceLiveResource() resource.Resource {
 
 // sourceLiveResource is the resource implementation.
 type sourceLiveResource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
+}
+
+// sourceLiveAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var sourceLiveAPIFields = map[string]path.Path{
+	"name":                 path.Root("name"),
+	"url":                  path.Root("url"),
+	"description":          path.Root("description"),
+	"multiPeriod":          path.Root("multi_period"),
+	"origin":               path.Root("origin"),
+	"origin.customHeaders": path.Root("origin").AtName("custom_headers"),
 }
 
 // Configure adds the provider configured client to the resource.
@@ -48,11 +61,11 @@ func (r *sourceLiveResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -67,7 +80,7 @@ func (r *sourceLiveResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *sourceLiveResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sourceLiveResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -137,19 +150,35 @@ func (r *sourceLiveResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "The origin configuration for the source live.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+// sourceLiveResourceModel is the state of a live source resource: the data
+// source model plus the operation timeouts.
+type sourceLiveResourceModel struct {
+	sourceLiveDataSourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *sourceLiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve the plan into a strongly typed model
-	var plan sourceLiveDataSourceModel
+	var plan sourceLiveResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the API input from the Terraform plan
 	sourceData := broadpeakio.LiveInput{
 		Name:        plan.Name.ValueString(),
@@ -176,12 +205,9 @@ func (r *sourceLiveResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Call the Broadpeak API to create the resource
-	source, err := r.client.CreateLive(sourceData)
+	source, err := r.client.CreateLive(ctx, sourceData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating source live",
-			fmt.Sprintf("Could not create source live: %s", err),
-		)
+		addAPIError(&resp.Diagnostics, "Error creating source live", "Could not create source live", err, sourceLiveAPIFields)
 		return
 	}
 
@@ -247,24 +273,29 @@ func (r *sourceLiveResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Save the state
-	diags = resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, sourceLiveResourceModel{result, plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *sourceLiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state sourceLiveDataSourceModel
+	var state sourceLiveResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	source, err := r.client.GetLive(uint(state.ID.ValueInt64()))
+	source, err := r.client.GetLive(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Source live no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Source Live",
-			fmt.Sprintf("Service with ID %d not found (%s)", state.ID.ValueInt64(), err.Error()),
+			fmt.Sprintf("Could not read live source ID %d: %s", state.ID.ValueInt64(), err.Error()),
 		)
 		return
 	}
@@ -318,7 +349,7 @@ func (r *sourceLiveResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Set state
-	state = sourceLiveDataSourceModel{
+	state.sourceLiveDataSourceModel = sourceLiveDataSourceModel{
 		ID:          types.Int64Value(int64(source.Id)),
 		Name:        types.StringValue(source.Name),
 		Type:        types.StringValue(source.Type),
@@ -338,13 +369,19 @@ func (r *sourceLiveResource) Update(ctx context.Context, req resource.UpdateRequ
 	// ---------------------------------------------------------------------
 	// 1. Load the planned state
 	// ---------------------------------------------------------------------
-	var plan sourceLiveDataSourceModel
+	var plan sourceLiveResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// ---------------------------------------------------------------------
 	// 2. Build LiveInput for the Broadpeak API
 	// ---------------------------------------------------------------------
@@ -380,18 +417,15 @@ func (r *sourceLiveResource) Update(ctx context.Context, req resource.UpdateRequ
 	// 3. Call the API to update
 	// ---------------------------------------------------------------------
 	liveID := uint(plan.ID.ValueInt64())
-	if _, err := r.client.UpdateLive(liveID, updateInput); err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Source Live",
-			fmt.Sprintf("Could not update source live ID %d: %s", liveID, err),
-		)
+	if _, err := r.client.UpdateLive(ctx, liveID, updateInput); err != nil {
+		addAPIError(&resp.Diagnostics, "Error Updating Source Live", fmt.Sprintf("Could not update source live ID %d", liveID), err, sourceLiveAPIFields)
 		return
 	}
 
 	// ---------------------------------------------------------------------
 	// 4. Re-query the updated object so the state is authoritative
 	// ---------------------------------------------------------------------
-	source, err := r.client.GetLive(liveID)
+	source, err := r.client.GetLive(ctx, liveID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Updated Source Live",
@@ -461,23 +495,30 @@ func (r *sourceLiveResource) Update(ctx context.Context, req resource.UpdateRequ
 		Origin:      originAttr,
 	}
 
-	diags = resp.State.Set(ctx, newState)
+	diags = resp.State.Set(ctx, sourceLiveResourceModel{newState, plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *sourceLiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state sourceLiveDataSourceModel
+	var state sourceLiveResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing live
-	_, err := r.client.DeleteLive(uint(state.ID.ValueInt64()))
-	if err != nil {
+	_, err := r.client.DeleteLive(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Source Live",
 			"Could not delete live, unexpected error: "+err.Error(),
@@ -2,19 +2,16 @@ package provider
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 // 1. Basic creation with required fields
 func TestAccSourceLive_Basic(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_live.test"
 
 	resource.Test(t, resource.TestCase{
@@ -49,10 +46,7 @@ resource "bpkio_source_live" "test" {
 
 // 2. Invalid URL (asset does not exist)
 func TestAccSourceLive_InvalidURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -81,10 +75,7 @@ resource "bpkio_source_live" "test" {
 
 // 3. Missing required field (name)
 func TestAccSourceLive_MissingName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -108,10 +99,7 @@ resource "bpkio_source_live" "test" {
 
 // 4. Duplicate name+url (if API returns error)
 func TestAccSourceLive_DuplicateNameURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	config := testAccSourceLiveDuplicateConfig(apiKey)
 	// Accept either a 500 or a 403 Forbidden error (API may be inconsistent)
 	re := regexp.MustCompile(`(?s)(Internal server error|Cannot\s+create\s+a\s+source\s+with\s+the\s+same\s+name\s+and\s+URL)`)
@@ -148,10 +136,7 @@ resource "bpkio_source_live" "second" {
 
 // 5. Check computed fields are always set
 func TestAccSourceLive_ComputedFields(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_live.test"
 
 	resource.Test(t, resource.TestCase{
@@ -172,10 +157,7 @@ func TestAccSourceLive_ComputedFields(t *testing.T) {
 
 // 6. Minimal config (omit optional description, multi_period, origin)
 func TestAccSourceLive_MinimalConfig(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_live.test"
 
 	resource.Test(t, resource.TestCase{
@@ -195,10 +177,7 @@ func TestAccSourceLive_MinimalConfig(t *testing.T) {
 
 // 7. Long names and special characters
 func TestAccSourceLive_LongSpecialName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_live.test"
 
 	name := "tf-acc-test-live-特殊字符-🚀-verylongname" + string(make([]byte, 80))
@@ -225,3 +204,56 @@ resource "bpkio_source_live" "test" {
 		},
 	})
 }
+
+// 8. Operation timeouts bound the API calls, and are kept out of imports
+func TestAccSourceLive_Timeouts(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_live.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Broadpeak hangs validating the source URL: the create gives
+				// up once its timeout expires.
+				PreConfig:   func() { testAccFakeAPI.Stall(1, time.Minute) },
+				Config:      testAccSourceLiveTimeoutsConfig(apiKey, "2s"),
+				ExpectError: regexp.MustCompile(`abandoned: the operation\s+timed out[\s\S]*may still have been\s+created`),
+			},
+			{
+				Config: testAccSourceLiveTimeoutsConfig(apiKey, "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "2m"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.delete", "1m"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccSourceLiveTimeoutsConfig(apiKey, create string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_live" "test" {
+  name = "tf-acc-test-live-timeouts"
+  url  = "https://hls-radio-s3.nextradiotv.com/olyzon/delayed/master.m3u8"
+
+  timeouts {
+    create = "%s"
+    delete = "1m"
+  }
+}
+`, apiKey, create)
+}
@@ -23,7 +23,7 @@ var (
 
 // sourceSlateDataSource is the data source implementation.
 type sourceSlateDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewSourceSlateDataSource is a helper function to simplify the provider implementation.
@@ -39,11 +39,11 @@ func (d *sourceSlateDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -61,12 +61,8 @@ func (d *sourceSlateDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *sourceSlateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Computed: true,
-			},
+			"id":   lookupIDAttribute("slate"),
+			"name": lookupNameAttribute("slate"),
 			"type": schema.StringAttribute{
 				Computed: true,
 			},
@@ -86,11 +82,16 @@ func (d *sourceSlateDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 // Read refreshes the Terraform state with the latest data.
 func (d *sourceSlateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state sourceSlateDataSourceModel
-	var sourceid int64
+	var id types.Int64
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diags := req.Config.GetAttribute(ctx, path.Root("id"), &sourceid)
+	sourceid, diags := lookupID(ctx, id, name, "slate", d.client.namedSources("slate"))
 	resp.Diagnostics.Append(diags...)
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -99,7 +100,7 @@ func (d *sourceSlateDataSource) Read(ctx context.Context, req datasource.ReadReq
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
 	// Get the source from the API
-	source, err := d.client.GetSlate(uint(sourceid))
+	source, err := d.client.GetSlate(ctx, uint(sourceid))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Single Service",
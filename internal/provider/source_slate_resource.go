@@ -10,6 +10,7 @@ import (
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -34,7 +36,15 @@ func NewSourceSlateResource() resource.Resource {
 
 // sourceSlateResource is the resource implementation.
 type sourceSlateResource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
+}
+
+// sourceSlateAPIFields maps the request fields the API may reject to the
+// attributes they are read from.
+var sourceSlateAPIFields = map[string]path.Path{
+	"name":        path.Root("name"),
+	"url":         path.Root("url"),
+	"description": path.Root("description"),
 }
 
 // Configure adds the provider configured client to the resource.
@@ -44,11 +54,11 @@ func (r *sourceSlateResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -63,7 +73,7 @@ func (r *sourceSlateResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *sourceSlateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *sourceSlateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -102,19 +112,35 @@ func (r *sourceSlateResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The format of the slate.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
+// sourceSlateResourceModel is the state of a slate resource: the data source
+// model plus the operation timeouts.
+type sourceSlateResourceModel struct {
+	sourceSlateDataSourceModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *sourceSlateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan sourceSlateDataSourceModel
+	var plan sourceSlateResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var sourceData = broadpeakio.SlateInput{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -122,17 +148,14 @@ func (r *sourceSlateResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create new slate
-	source, err := r.client.CreateSlate(sourceData)
+	source, err := r.client.CreateSlate(ctx, sourceData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating slate",
-			"Could not create slate, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error creating slate", "Could not create slate", err, sourceSlateAPIFields)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	plan = sourceSlateDataSourceModel{
+	plan.sourceSlateDataSourceModel = sourceSlateDataSourceModel{
 		ID:          types.Int64Value(int64(source.Id)),
 		Name:        types.StringValue(source.Name),
 		Type:        types.StringValue(source.Type),
@@ -152,7 +175,7 @@ func (r *sourceSlateResource) Create(ctx context.Context, req resource.CreateReq
 // Read refreshes the Terraform state with the latest data.
 func (r *sourceSlateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var state sourceSlateDataSourceModel
+	var state sourceSlateResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -160,16 +183,21 @@ func (r *sourceSlateResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Get refreshed slate value from HashiCups
-	source, err := r.client.GetSlate(uint(state.ID.ValueInt64()))
+	source, err := r.client.GetSlate(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Source slate no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Single Service",
-			fmt.Sprintf("Service with ID %d not found (%s)", state.ID, err.Error()),
+			"Unable to Read Source Slate",
+			fmt.Sprintf("Could not read slate ID %d: %s", state.ID.ValueInt64(), err.Error()),
 		)
 		return
 	}
 
-	state = sourceSlateDataSourceModel{
+	state.sourceSlateDataSourceModel = sourceSlateDataSourceModel{
 		ID:          types.Int64Value(int64(source.Id)),
 		Name:        types.StringValue(source.Name),
 		Type:        types.StringValue(source.Type),
@@ -189,7 +217,7 @@ func (r *sourceSlateResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *sourceSlateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan and current state
-	var plan sourceSlateDataSourceModel
+	var plan sourceSlateResourceModel
 
 	// Get planned changes
 	diags := req.Plan.Get(ctx, &plan)
@@ -198,6 +226,12 @@ func (r *sourceSlateResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Prepare the update data
 	var sourceData = broadpeakio.SlateInput{
 		Name:        plan.Name.ValueString(),
@@ -206,17 +240,14 @@ func (r *sourceSlateResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	slateID := uint(plan.ID.ValueInt64())
 	// Update existing slate
-	_, err := r.client.UpdateSlate(slateID, sourceData)
+	_, err := r.client.UpdateSlate(ctx, slateID, sourceData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating slate",
-			"Could not update slate, unexpected error: "+err.Error(),
-		)
+		addAPIError(&resp.Diagnostics, "Error updating slate", "Could not update slate", err, sourceSlateAPIFields)
 		return
 	}
 
 	// Fetch updated items from GetSlate
-	source, err := r.client.GetSlate(slateID)
+	source, err := r.client.GetSlate(ctx, slateID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Slate",
@@ -236,7 +267,7 @@ func (r *sourceSlateResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Set state to fully populated data
-	diags = resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, sourceSlateResourceModel{result, plan.Timeouts})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -246,16 +277,23 @@ func (r *sourceSlateResource) Update(ctx context.Context, req resource.UpdateReq
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *sourceSlateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state sourceSlateDataSourceModel
+	var state sourceSlateResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete existing slate
-	_, err := r.client.DeleteSlate(uint(state.ID.ValueInt64()))
-	if err != nil {
+	_, err := r.client.DeleteSlate(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Source Slate",
 			"Could not delete slate, unexpected error: "+err.Error(),
@@ -2,18 +2,15 @@ package provider
 
 import (
 	"fmt"
-	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccSourceSlate_Basic(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resourceName := "bpkio_source_slate.test"
 
@@ -48,10 +45,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_InvalidURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	badURL := "https://this-url-does-not-exist.broadpeak.io/foo.jpg"
 
@@ -77,10 +71,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_Update(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resourceName := "bpkio_source_slate.test"
 
@@ -128,10 +119,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_Import(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 
 	resourceName := "bpkio_source_slate.test"
 	name := "tf-acc-test-slate-import"
@@ -161,10 +149,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_MissingName(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories(),
@@ -183,10 +168,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_MissingURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories(),
@@ -205,10 +187,7 @@ resource "bpkio_source_slate" "test" {
 }
 
 func TestAccSourceSlate_DuplicateNameURL(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	name := "tf-acc-test-duplicate"
 	url := "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
 
@@ -237,10 +216,7 @@ resource "bpkio_source_slate" "second" {
 }
 
 func TestAccSourceSlate_ComputedFields(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_slate.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -259,10 +235,7 @@ func TestAccSourceSlate_ComputedFields(t *testing.T) {
 }
 
 func TestAccSourceSlate_MinimalConfig(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	resourceName := "bpkio_source_slate.minimal"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -288,10 +261,7 @@ resource "bpkio_source_slate" "minimal" {
 }
 
 func TestAccSourceSlate_LongNameAndSpecialChars(t *testing.T) {
-	apiKey := os.Getenv("BPKIO_API_KEY")
-	if apiKey == "" {
-		t.Fatal("BPKIO_API_KEY must be set for acceptance tests")
-	}
+	apiKey := testAccAPIKey()
 	longName := "tf-acc-test-超级长的名字-🚀-abcdefghijklmnopqrstuvwxyz0123456789"
 	resourceName := "bpkio_source_slate.special"
 	resource.Test(t, resource.TestCase{
@@ -314,3 +284,119 @@ resource "bpkio_source_slate" "special" {
 		},
 	})
 }
+
+func TestAccSourceSlate_Drift(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_slate.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceSlateConfig(apiKey),
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				// Someone edits the slate outside of Terraform.
+				PreConfig: func() {
+					testAccFakeAPI.SetSourceField(id, "description", "changed out of band")
+				},
+				Config:             testAccSourceSlateConfig(apiKey),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSourceSlateConfig(apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceSlate_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_slate.test"
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceSlateConfig(apiKey),
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				// The slate is deleted outside of Terraform: the refresh must
+				// drop it from state and plan to create it again.
+				PreConfig: func() {
+					testAccFakeAPI.DeleteSource(id)
+				},
+				Config:             testAccSourceSlateConfig(apiKey),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSourceSlateConfig(apiKey),
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+func TestAccSourceSlate_InterruptedUpdate(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_source_slate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceSlateInterruptedConfig(apiKey, "first description"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "description", "first description"),
+			},
+			{
+				// The update is abandoned when its timeout expires.
+				PreConfig:   func() { testAccFakeAPI.Stall(1, time.Minute) },
+				Config:      testAccSourceSlateInterruptedConfig(apiKey, "second description"),
+				ExpectError: regexp.MustCompile(`PUT sources/slate/\d+ abandoned: the operation\s+timed\s+out`),
+			},
+			{
+				// The prior state is kept, so the update is still pending.
+				Config:             testAccSourceSlateInterruptedConfig(apiKey, "second description"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSourceSlateInterruptedConfig(apiKey, "second description"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "description", "second description"),
+			},
+		},
+	})
+}
+
+func testAccSourceSlateInterruptedConfig(apiKey, description string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_slate" "test" {
+  name        = "tf-acc-test-slate-interrupted"
+  url         = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+  description = "%s"
+
+  timeouts {
+    update = "2s"
+  }
+}
+`, apiKey, description)
+}
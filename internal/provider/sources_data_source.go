@@ -6,12 +6,15 @@ package provider
 import (
 	"context"
 	"fmt"
-
-	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -24,7 +27,7 @@ var (
 
 // sourcesDataSource is the data source implementation.
 type sourcesDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 // NewSourcesDataSource is a helper function to simplify the provider implementation.
@@ -40,11 +43,11 @@ func (d *sourcesDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	client, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -61,15 +64,46 @@ func (d *sourcesDataSource) Metadata(_ context.Context, req datasource.MetadataR
 // Schema defines the schema for the data source.
 func (d *sourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Lists the sources of the tenant. Filters are combined: a source is listed when it matches all of them.",
 		Attributes: map[string]schema.Attribute{
 			"type": schema.StringAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Only list the sources of this type.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("live", "asset", "asset-catalog", "slate", "ad-server"),
 				},
 			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the sources whose name matches this RE2 regular expression.",
+				Validators: []validator.String{
+					isRegexp(),
+				},
+			},
+			"url_contains": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the sources whose URL contains this string.",
+			},
+			"format": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the sources of this format, e.g. `hls` or `dash`. Case-insensitive.",
+			},
+			"ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "Only list the sources with these IDs.",
+			},
+			"sort_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "Attribute the sources are sorted by: `id` (default), `name` or `type`. Ties are broken by ID. `max_results` keeps the first sources in this order.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("id", "name", "type"),
+				},
+			},
+			"max_results": maxResultsAttribute("sources", "in `sort_by` order"),
 			"sources": schema.ListNestedAttribute{
-				Computed: true,
+				Computed:    true,
+				Description: "Sources matching the filters.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.Int64Attribute{
@@ -84,6 +118,15 @@ func (d *sourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 						"url": schema.StringAttribute{
 							Computed: true,
 						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"format": schema.StringAttribute{
+							Computed: true,
+						},
+						"multi_period": schema.BoolAttribute{
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -97,71 +140,150 @@ func (d *sourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	sources, err := d.client.GetAllSources(0, 2000)
+	filter, diags := expandSourcesFilter(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// max_results applies to the sorted sources, so every match is listed
+	// first.
+	sources, status, err := listAll(ctx, d.client.pageSize, 0, d.client.GetAllSources, filter.matches)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read HashiCups Sources",
+			"Unable to List Sources",
 			err.Error(),
 		)
 		return
 	}
 
-	// Map response body to model
-	for _, source := range sources {
-		sourceState := sourcesModel{
-			ID:   types.Int64Value(int64(source.Id)),
-			Name: types.StringValue(source.Name),
-			Type: types.StringValue(source.Type),
-			URL:  types.StringValue(source.Url),
+	state.Sources, status.truncated = truncateResults(flattenSources(sources, filter), int(state.MaxResults.ValueInt64()))
+	addListWarnings(&resp.Diagnostics, "sources", state.MaxResults, status)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sourcesFilter selects and orders the sources listed by the data source.
+// Zero fields do not filter.
+type sourcesFilter struct {
+	Type        string
+	NameRegex   *regexp.Regexp
+	URLContains string
+	Format      string
+	IDs         map[uint]bool
+	SortBy      string
+}
+
+// expandSourcesFilter builds the filter of the data source from its
+// configuration.
+func expandSourcesFilter(ctx context.Context, m sourcesDataSourceModel) (sourcesFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	f := sourcesFilter{
+		Type:        m.Type.ValueString(),
+		URLContains: m.URLContains.ValueString(),
+		Format:      m.Format.ValueString(),
+		SortBy:      m.SortBy.ValueString(),
+	}
+
+	if !m.NameRegex.IsNull() {
+		re, err := regexp.Compile(m.NameRegex.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return f, diags
 		}
+		f.NameRegex = re
+	}
 
-		if state.Type.IsNull() || (source.Type == state.Type.ValueString() && !state.Type.IsNull()) {
-			state.Sources = append(state.Sources, sourceState)
+	if !m.IDs.IsNull() {
+		var ids []int64
+		diags.Append(m.IDs.ElementsAs(ctx, &ids, false)...)
+		f.IDs = make(map[uint]bool, len(ids))
+		for _, id := range ids {
+			f.IDs[uint(id)] = true
 		}
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	return f, diags
+}
+
+// matches reports whether a source passes every filter.
+func (f sourcesFilter) matches(s sourceListOutput) bool {
+	switch {
+	case f.Type != "" && s.Type != f.Type:
+		return false
+	case f.NameRegex != nil && !f.NameRegex.MatchString(s.Name):
+		return false
+	case f.URLContains != "" && !strings.Contains(s.Url, f.URLContains):
+		return false
+	case f.Format != "" && !strings.EqualFold(s.Format, f.Format):
+		return false
+	case f.IDs != nil && !f.IDs[s.Id]:
+		return false
 	}
+	return true
 }
 
-func flattenSources(sdkSources []broadpeakio.Source, filterType *string) []sourcesModel {
-	var result []sourcesModel
+// flattenSources maps the sources returned by the API that pass the filter,
+// in the order it asks for.
+func flattenSources(sources []sourceListOutput, f sourcesFilter) []sourcesModel {
+	matched := make([]sourceListOutput, 0, len(sources))
+	for _, s := range sources {
+		if f.matches(s) {
+			matched = append(matched, s)
+		}
+	}
 
-	for _, s := range sdkSources {
-		if filterType != nil && *filterType != "" && s.Type != *filterType {
-			continue
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+		switch {
+		case f.SortBy == "name" && a.Name != b.Name:
+			return a.Name < b.Name
+		case f.SortBy == "type" && a.Type != b.Type:
+			return a.Type < b.Type
 		}
+		return a.Id < b.Id
+	})
+
+	result := make([]sourcesModel, 0, len(matched))
+	for _, s := range matched {
 		result = append(result, sourcesModel{
-			ID:   types.Int64Value(int64(s.Id)),
-			Name: types.StringValue(s.Name),
-			Type: types.StringValue(s.Type),
-			URL:  types.StringValue(s.Url),
+			ID:          types.Int64Value(int64(s.Id)),
+			Name:        types.StringValue(s.Name),
+			Type:        types.StringValue(s.Type),
+			URL:         types.StringValue(s.Url),
+			Description: types.StringValue(s.Description),
+			Format:      types.StringValue(s.Format),
+			MultiPeriod: types.BoolValue(s.MultiPeriod),
 		})
 	}
-
-	if result == nil {
-		return []sourcesModel{} // 👈 force non-nil return
-	}
-
 	return result
 }
 
 // sourcesDataSourceModel maps the data source schema data.
 type sourcesDataSourceModel struct {
-	Type    types.String   `tfsdk:"type"`
-	Sources []sourcesModel `tfsdk:"sources"`
+	Type        types.String   `tfsdk:"type"`
+	NameRegex   types.String   `tfsdk:"name_regex"`
+	URLContains types.String   `tfsdk:"url_contains"`
+	Format      types.String   `tfsdk:"format"`
+	IDs         types.Set      `tfsdk:"ids"`
+	SortBy      types.String   `tfsdk:"sort_by"`
+	MaxResults  types.Int64    `tfsdk:"max_results"`
+	Sources     []sourcesModel `tfsdk:"sources"`
 }
 
 // sourcesModel maps sources schema data.
 type sourcesModel struct {
-	ID   types.Int64  `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
-	URL  types.String `tfsdk:"url"`
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	URL         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+	Format      types.String `tfsdk:"format"`
+	MultiPeriod types.Bool   `tfsdk:"multi_period"`
 }
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourcesDataSource_Filters(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourcesFilterConfig(apiKey, `
+data "bpkio_sources" "test" {
+  name_regex = "[unclosed"
+}
+`),
+				ExpectError: regexp.MustCompile(`valid RE2 regular expression`),
+			},
+			{
+				Config: testAccSourcesFilterConfig(apiKey, `
+data "bpkio_sources" "by_name" {
+  name_regex = "^tf-acc-sources-"
+  sort_by    = "name"
+
+  depends_on = [bpkio_source_live.b, bpkio_source_slate.a]
+}
+
+data "bpkio_sources" "by_format" {
+  name_regex   = "^tf-acc-sources-"
+  format       = "HLS"
+  url_contains = "nextradiotv"
+
+  depends_on = [bpkio_source_live.b, bpkio_source_slate.a]
+}
+
+data "bpkio_sources" "by_ids" {
+  ids = [bpkio_source_slate.a.id]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.#", "2"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.0.name", "tf-acc-sources-a"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.0.format", "jpeg"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.0.description", "Slate of the filter test"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.1.name", "tf-acc-sources-b"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_name", "sources.1.multi_period", "false"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_format", "sources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bpkio_sources.by_format", "sources.0.id", "bpkio_source_live.b", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.by_ids", "sources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bpkio_sources.by_ids", "sources.0.id", "bpkio_source_slate.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSourcesFilterConfig(apiKey, dataSources string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_source_live" "b" {
+  name = "tf-acc-sources-b"
+  url  = "https://hls-radio-s3.nextradiotv.com/olyzon/delayed/master.m3u8"
+}
+
+resource "bpkio_source_slate" "a" {
+  name        = "tf-acc-sources-a"
+  description = "Slate of the filter test"
+  url         = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+}
+`, apiKey) + dataSources
+}
+
+func TestAccSourcesDataSource_Pagination(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key        = "%s"
+  list_page_size = 1
+}
+
+resource "bpkio_source_live" "b" {
+  name = "tf-acc-sources-page-b"
+  url  = "https://hls-radio-s3.nextradiotv.com/olyzon/delayed/master.m3u8"
+}
+
+resource "bpkio_source_slate" "a" {
+  name = "tf-acc-sources-page-a"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+
+  # Created last, so listed last by the API.
+  depends_on = [bpkio_source_live.b]
+}
+
+data "bpkio_sources" "all" {
+  name_regex = "^tf-acc-sources-page-"
+
+  depends_on = [bpkio_source_live.b, bpkio_source_slate.a]
+}
+
+data "bpkio_sources" "capped" {
+  name_regex  = "^tf-acc-sources-page-"
+  max_results = 1
+
+  depends_on = [bpkio_source_live.b, bpkio_source_slate.a]
+}
+
+data "bpkio_sources" "first_by_name" {
+  name_regex  = "^tf-acc-sources-page-"
+  sort_by     = "name"
+  max_results = 1
+
+  depends_on = [bpkio_source_live.b, bpkio_source_slate.a]
+}
+`, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bpkio_sources.all", "sources.#", "2"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.capped", "sources.#", "1"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.first_by_name", "sources.#", "1"),
+					resource.TestCheckResourceAttr("data.bpkio_sources.first_by_name", "sources.0.name", "tf-acc-sources-page-a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourcesDataSource_CappedPages(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	t.Cleanup(func() { testAccFakeAPI.LimitPages(0) })
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The API serves 2 sources per page although 3 are asked for.
+				PreConfig: func() { testAccFakeAPI.LimitPages(2) },
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key        = "%s"
+  list_page_size = 3
+}
+
+resource "bpkio_source_slate" "page" {
+  count = 3
+
+  name = "tf-acc-sources-capped-${count.index}"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/broadpeakio-slate.jpg"
+}
+
+data "bpkio_sources" "all" {
+  name_regex = "^tf-acc-sources-capped-"
+
+  depends_on = [bpkio_source_slate.page]
+}
+`, testAccAPIKey()),
+				Check: resource.TestCheckResourceAttr("data.bpkio_sources.all", "sources.#", "3"),
+			},
+		},
+	})
+}
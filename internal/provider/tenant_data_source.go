@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tenantDataSource{}
+	_ datasource.DataSourceWithConfigure = &tenantDataSource{}
+)
+
+// tenantDataSource is the data source implementation.
+type tenantDataSource struct {
+	client *bpkioClient
+}
+
+// NewTenantDataSource is a helper function to simplify the provider implementation.
+func NewTenantDataSource() datasource.DataSource {
+	return &tenantDataSource{}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tenantDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Metadata returns the data source type name.
+func (d *tenantDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tenant"
+}
+
+// Schema defines the schema for the data source.
+func (d *tenantDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the tenant the configured API key belongs to, and the user that owns the key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the tenant.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the tenant.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the tenant.",
+			},
+			"commercial_plan": schema.StringAttribute{
+				Computed:    true,
+				Description: "The commercial plan the tenant is subscribed to.",
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the tenant.",
+			},
+			"owner": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The user the API key was issued to.",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The ID of the user.",
+					},
+					"email": schema.StringAttribute{
+						Computed:    true,
+						Description: "The email address of the user.",
+					},
+					"first_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The first name of the user.",
+					},
+					"last_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The last name of the user.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tenantDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tenant, err := d.client.GetTenant(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Tenant",
+			err.Error(),
+		)
+		return
+	}
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read API Key Owner",
+			err.Error(),
+		)
+		return
+	}
+
+	state := flattenTenant(tenant, user)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func flattenTenant(tenant tenantOutput, user broadpeakio.UserOutput) tenantDataSourceModel {
+	return tenantDataSourceModel{
+		ID:             types.Int64Value(int64(tenant.Id)),
+		Name:           types.StringValue(tenant.Name),
+		Description:    types.StringValue(tenant.Description),
+		CommercialPlan: types.StringValue(tenant.CommercialPlan),
+		State:          types.StringValue(tenant.State),
+		Owner: &tenantOwnerModel{
+			ID:        types.Int64Value(int64(user.Id)),
+			Email:     types.StringValue(user.Email),
+			FirstName: types.StringValue(user.FirstName),
+			LastName:  types.StringValue(user.LastName),
+		},
+	}
+}
+
+// tenantDataSourceModel maps the data source schema data.
+type tenantDataSourceModel struct {
+	ID             types.Int64       `tfsdk:"id"`
+	Name           types.String      `tfsdk:"name"`
+	Description    types.String      `tfsdk:"description"`
+	CommercialPlan types.String      `tfsdk:"commercial_plan"`
+	State          types.String      `tfsdk:"state"`
+	Owner          *tenantOwnerModel `tfsdk:"owner"`
+}
+
+// tenantOwnerModel maps the user that owns the API key.
+type tenantOwnerModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
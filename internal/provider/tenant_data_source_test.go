@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenTenant(t *testing.T) {
+	got := flattenTenant(
+		tenantOutput{Id: 12, Name: "acme", Description: "Acme Corp", CommercialPlan: "ENTERPRISE", State: "enabled"},
+		broadpeakio.UserOutput{Id: 3, Email: "ops@acme.test", FirstName: "Ada", LastName: "Lovelace", TenantId: 12},
+	)
+
+	require.Equal(t, tenantDataSourceModel{
+		ID:             types.Int64Value(12),
+		Name:           types.StringValue("acme"),
+		Description:    types.StringValue("Acme Corp"),
+		CommercialPlan: types.StringValue("ENTERPRISE"),
+		State:          types.StringValue("enabled"),
+		Owner: &tenantOwnerModel{
+			ID:        types.Int64Value(3),
+			Email:     types.StringValue("ops@acme.test"),
+			FirstName: types.StringValue("Ada"),
+			LastName:  types.StringValue("Lovelace"),
+		},
+	}, got)
+}
+
+func TestAccTenantDataSource_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	dataSourceName := "data.bpkio_tenant.current"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+data "bpkio_tenant" "current" {}
+`, apiKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "commercial_plan"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "owner.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "owner.email"),
+				),
+			},
+		},
+	})
+}
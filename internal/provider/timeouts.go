@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default operation timeouts of the resources, used when the timeouts block
+// leaves them unset. Creates and updates may wait on Broadpeak validating a
+// source URL; deletes are a single call.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// timeoutsBlock is the timeouts block every resource accepts.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// timeoutFunc reads one operation timeout of a timeouts block, e.g.
+// timeouts.Value.Create.
+type timeoutFunc func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// withTimeout bounds ctx by the operation timeout timeout reads, or
+// defaultTimeout when it is unset. Every API call made with the returned
+// context is abandoned once the timeout expires.
+func withTimeout(ctx context.Context, timeout timeoutFunc, defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	d, ds := timeout(ctx, defaultTimeout)
+	diags.Append(ds...)
+	return context.WithTimeout(ctx, d)
+}
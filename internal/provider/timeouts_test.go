@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithTimeout(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"create": types.StringType,
+		"update": types.StringType,
+		"delete": types.StringType,
+	}
+	configured := timeouts.Value{Object: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"create": types.StringValue("30s"),
+		"update": types.StringNull(),
+		"delete": types.StringValue("forever"),
+	})}
+	unset := timeouts.Value{Object: types.ObjectNull(attrTypes)}
+
+	tests := map[string]struct {
+		timeout timeoutFunc
+		want    time.Duration
+		wantErr bool
+	}{
+		"configured":    {timeout: configured.Create, want: 30 * time.Second},
+		"unset field":   {timeout: configured.Update, want: time.Hour},
+		"unset block":   {timeout: unset.Delete, want: time.Hour},
+		"invalid value": {timeout: configured.Delete, want: time.Hour, wantErr: true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			start := time.Now()
+			ctx, cancel := withTimeout(context.Background(), tt.timeout, time.Hour, &diags)
+			defer cancel()
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("context has no deadline")
+			}
+			if got := deadline.Sub(start); got < tt.want-time.Second || got > tt.want+time.Second {
+				t.Errorf("deadline in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// transcodingLadderAttrTypes are the attribute types of the ladder of a
+// transcoding profile.
+var transcodingLadderAttrTypes = map[string]attr.Type{
+	"video": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"codec":      types.StringType,
+		"bitrate":    types.StringType,
+		"resolution": types.StringType,
+		"width":      types.Int64Type,
+		"height":     types.Int64Type,
+		"framerate":  types.StringType,
+	}}},
+	"audio": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"codec":   types.StringType,
+		"bitrate": types.StringType,
+	}}},
+	"packaging": types.MapType{ElemType: types.StringType},
+}
+
+// transcodingLadderAttribute describes the ladder parsed from the content of
+// a transcoding profile.
+func transcodingLadderAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		Description: "Renditions and packaging settings parsed from `content`. " +
+			"Null when the content is not a transcoding profile document.",
+		Attributes: map[string]schema.Attribute{
+			"video": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Video renditions, in the order of the profile jobs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"codec": schema.StringAttribute{
+							Computed:    true,
+							Description: "Video codec, e.g. `h264`.",
+						},
+						"bitrate": schema.StringAttribute{
+							Computed:    true,
+							Description: "Video bitrate, as written in the profile, e.g. `1500k`.",
+						},
+						"resolution": schema.StringAttribute{
+							Computed:    true,
+							Description: "Resolution, as `<width>x<height>`. Null unless the profile sets both dimensions.",
+						},
+						"width": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Width of the rendition, in pixels. Null when it is kept from the source or derived from the aspect ratio, e.g. `-2`.",
+						},
+						"height": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Height of the rendition, in pixels. Null when it is kept from the source or derived from the aspect ratio, e.g. `-2`.",
+						},
+						"framerate": schema.StringAttribute{
+							Computed:    true,
+							Description: "Frame rate, e.g. `25` or `29.97`.",
+						},
+					},
+				},
+			},
+			"audio": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Audio renditions, in the order of the profile jobs.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"codec": schema.StringAttribute{
+							Computed:    true,
+							Description: "Audio codec, e.g. `aac`.",
+						},
+						"bitrate": schema.StringAttribute{
+							Computed:    true,
+							Description: "Audio bitrate, as written in the profile, e.g. `128k`.",
+						},
+					},
+				},
+			},
+			"packaging": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Packaging settings of the profile, e.g. `--hls-client-manifest-version`.",
+			},
+		},
+	}
+}
+
+// transcodingProfileContent is the part of a transcoding profile document the
+// ladder is parsed from.
+type transcodingProfileContent struct {
+	Packaging   map[string]any `json:"packaging"`
+	Transcoding *struct {
+		Jobs []map[string]any `json:"jobs"`
+	} `json:"transcoding"`
+}
+
+// parseTranscodingLadder parses the ladder of a transcoding profile document.
+// Jobs are listed in the order of the document; jobs of other types are
+// ignored. A video job whose scaling cannot be read is listed without a
+// resolution, and reported in jobErrs.
+func parseTranscodingLadder(content string) (ladder *transcodingLadderModel, jobErrs []error, err error) {
+	var doc transcodingProfileContent
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, nil, err
+	}
+	if doc.Transcoding == nil {
+		return nil, nil, fmt.Errorf("no transcoding jobs")
+	}
+
+	ladder = &transcodingLadderModel{
+		Video:     []videoRenditionModel{},
+		Audio:     []audioRenditionModel{},
+		Packaging: make(map[string]types.String, len(doc.Packaging)),
+	}
+	for i, job := range doc.Transcoding.Jobs {
+		switch ladderField(job, "type") {
+		case "video":
+			v := videoRenditionModel{
+				Codec:      types.StringValue(ladderField(job, "codecv")),
+				Bitrate:    types.StringValue(ladderField(job, "bitratev")),
+				Resolution: types.StringNull(),
+				Width:      types.Int64Null(),
+				Height:     types.Int64Null(),
+				Framerate:  types.StringValue(ladderField(job, "framerate")),
+			}
+			width, height, err := parseScaling(ladderField(job, "scaling"))
+			if err != nil {
+				name := fmt.Sprintf("job %d", i)
+				if level := ladderField(job, "level"); level != "" {
+					name += fmt.Sprintf(" (level %s)", level)
+				}
+				jobErrs = append(jobErrs, fmt.Errorf("%s: %w", name, err))
+			}
+			if width > 0 {
+				v.Width = types.Int64Value(width)
+			}
+			if height > 0 {
+				v.Height = types.Int64Value(height)
+			}
+			if width > 0 && height > 0 {
+				v.Resolution = types.StringValue(fmt.Sprintf("%dx%d", width, height))
+			}
+			ladder.Video = append(ladder.Video, v)
+		case "audio":
+			ladder.Audio = append(ladder.Audio, audioRenditionModel{
+				Codec:   types.StringValue(ladderField(job, "codeca")),
+				Bitrate: types.StringValue(ladderField(job, "bitratea")),
+			})
+		}
+	}
+	for k := range doc.Packaging {
+		ladder.Packaging[k] = types.StringValue(ladderField(doc.Packaging, k))
+	}
+	return ladder, jobErrs, nil
+}
+
+// parseScaling parses the "<width>:<height>" scaling of a video job. An empty
+// scaling keeps the source resolution and yields zeroes, as do the negative
+// dimensions ffmpeg derives from the aspect ratio, e.g. "-2:720".
+func parseScaling(scaling string) (int64, int64, error) {
+	if scaling == "" {
+		return 0, 0, nil
+	}
+	w, h, ok := strings.Cut(scaling, ":")
+	width, errW := strconv.ParseInt(w, 10, 64)
+	height, errH := strconv.ParseInt(h, 10, 64)
+	if !ok || errW != nil || errH != nil {
+		return 0, 0, fmt.Errorf("invalid scaling %q, expected <width>:<height>", scaling)
+	}
+	return max(width, 0), max(height, 0), nil
+}
+
+// ladderField returns a field of a profile document as a string. Profiles
+// mostly quote their values, but numbers and booleans are accepted too.
+func ladderField(obj map[string]any, key string) string {
+	switch v := obj[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// flattenTranscodingLadder returns the ladder of a transcoding profile as an
+// object value, null when its content cannot be parsed. Jobs that cannot be
+// read are reported as warnings.
+func flattenTranscodingLadder(ctx context.Context, id uint, content string) (types.Object, diag.Diagnostics) {
+	ladder, jobErrs, err := parseTranscodingLadder(content)
+	if err != nil {
+		tflog.Warn(ctx, "Could not parse the ladder of transcoding profile", map[string]interface{}{"id": id, "error": err.Error()})
+		return types.ObjectNull(transcodingLadderAttrTypes), nil
+	}
+
+	var diags diag.Diagnostics
+	for _, err := range jobErrs {
+		diags.AddWarning(
+			"Unreadable Transcoding Job",
+			fmt.Sprintf("Transcoding profile ID %d, %s. The rendition is listed without a resolution.", id, err),
+		)
+	}
+	obj, ds := types.ObjectValueFrom(ctx, transcodingLadderAttrTypes, ladder)
+	diags.Append(ds...)
+	return obj, diags
+}
+
+// transcodingLadderModel maps the ladder of a transcoding profile.
+type transcodingLadderModel struct {
+	Video     []videoRenditionModel   `tfsdk:"video"`
+	Audio     []audioRenditionModel   `tfsdk:"audio"`
+	Packaging map[string]types.String `tfsdk:"packaging"`
+}
+
+// videoRenditionModel maps a video job of a transcoding profile.
+type videoRenditionModel struct {
+	Codec      types.String `tfsdk:"codec"`
+	Bitrate    types.String `tfsdk:"bitrate"`
+	Resolution types.String `tfsdk:"resolution"`
+	Width      types.Int64  `tfsdk:"width"`
+	Height     types.Int64  `tfsdk:"height"`
+	Framerate  types.String `tfsdk:"framerate"`
+}
+
+// audioRenditionModel maps an audio job of a transcoding profile.
+type audioRenditionModel struct {
+	Codec   types.String `tfsdk:"codec"`
+	Bitrate types.String `tfsdk:"bitrate"`
+}
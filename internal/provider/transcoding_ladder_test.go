@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTranscodingLadder(t *testing.T) {
+	ladder, jobErrs, err := parseTranscodingLadder(`{
+		"packaging": {"--hls-client-manifest-version": "4", "--hls-fmp4": true},
+		"servicetype": "offline_transcoding",
+		"transcoding": {"jobs": [
+			{"level": "0", "type": "video", "codecv": "h264", "bitratev": "3000k", "scaling": "1280:720", "framerate": "25"},
+			{"level": "1", "type": "audio", "codeca": "aac", "bitratea": "128k"},
+			{"level": "2", "type": "video", "codecv": "h264", "bitratev": "800k", "framerate": 29.97},
+			{"level": "3", "type": "subtitles"}
+		]}
+	}`)
+	require.NoError(t, err)
+	require.Empty(t, jobErrs)
+
+	require.Len(t, ladder.Video, 2)
+	assert.Equal(t, "h264", ladder.Video[0].Codec.ValueString())
+	assert.Equal(t, "3000k", ladder.Video[0].Bitrate.ValueString())
+	assert.Equal(t, "1280x720", ladder.Video[0].Resolution.ValueString())
+	assert.Equal(t, int64(720), ladder.Video[0].Height.ValueInt64())
+	assert.Equal(t, "25", ladder.Video[0].Framerate.ValueString())
+	// Without scaling, the rendition keeps the source resolution.
+	assert.True(t, ladder.Video[1].Resolution.IsNull())
+	assert.True(t, ladder.Video[1].Width.IsNull())
+	assert.Equal(t, "29.97", ladder.Video[1].Framerate.ValueString())
+
+	require.Len(t, ladder.Audio, 1)
+	assert.Equal(t, "aac", ladder.Audio[0].Codec.ValueString())
+	assert.Equal(t, "128k", ladder.Audio[0].Bitrate.ValueString())
+
+	assert.Equal(t, "4", ladder.Packaging["--hls-client-manifest-version"].ValueString())
+	assert.Equal(t, "true", ladder.Packaging["--hls-fmp4"].ValueString())
+}
+
+func TestParseTranscodingLadder_Invalid(t *testing.T) {
+	for _, content := range []string{
+		``,
+		`{"packaging": {}}`,
+	} {
+		_, _, err := parseTranscodingLadder(content)
+		assert.Error(t, err, content)
+	}
+}
+
+func TestParseTranscodingLadder_InvalidJob(t *testing.T) {
+	ladder, jobErrs, err := parseTranscodingLadder(`{"transcoding": {"jobs": [
+		{"level": "0", "type": "video", "scaling": "1280:720"},
+		{"level": "1", "type": "video", "scaling": "1280x720"}
+	]}}`)
+	require.NoError(t, err)
+
+	// The other jobs are still listed.
+	require.Len(t, ladder.Video, 2)
+	assert.Equal(t, "1280x720", ladder.Video[0].Resolution.ValueString())
+	assert.True(t, ladder.Video[1].Resolution.IsNull())
+	require.Len(t, jobErrs, 1)
+	assert.EqualError(t, jobErrs[0], `job 1 (level 1): invalid scaling "1280x720", expected <width>:<height>`)
+}
+
+func TestParseScaling(t *testing.T) {
+	tests := []struct {
+		scaling       string
+		width, height int64
+	}{
+		{scaling: "", width: 0, height: 0},
+		{scaling: "1280:720", width: 1280, height: 720},
+		// ffmpeg derives negative dimensions from the aspect ratio.
+		{scaling: "-2:720", width: 0, height: 720},
+		{scaling: "-1:-1", width: 0, height: 0},
+	}
+
+	for _, tt := range tests {
+		width, height, err := parseScaling(tt.scaling)
+		require.NoError(t, err, tt.scaling)
+		assert.Equal(t, tt.width, width, tt.scaling)
+		assert.Equal(t, tt.height, height, tt.scaling)
+	}
+}
+
+func TestParseTranscodingLadder_DerivedDimensions(t *testing.T) {
+	ladder, jobErrs, err := parseTranscodingLadder(`{"transcoding": {"jobs": [
+		{"type": "video", "scaling": "-2:720"},
+		{"type": "video", "scaling": "-1:-1"}
+	]}}`)
+	require.NoError(t, err)
+	require.Empty(t, jobErrs)
+
+	require.Len(t, ladder.Video, 2)
+	assert.True(t, ladder.Video[0].Width.IsNull())
+	assert.Equal(t, int64(720), ladder.Video[0].Height.ValueInt64())
+	assert.True(t, ladder.Video[0].Resolution.IsNull())
+	assert.True(t, ladder.Video[1].Width.IsNull())
+	assert.True(t, ladder.Video[1].Height.IsNull())
+	assert.True(t, ladder.Video[1].Resolution.IsNull())
+}
+
+func TestFlattenTranscodingLadder_InvalidJob(t *testing.T) {
+	ladder, diags := flattenTranscodingLadder(context.Background(), 7, `{"transcoding": {"jobs": [
+		{"level": "3", "type": "video", "scaling": "wide"}
+	]}}`)
+	require.False(t, diags.HasError())
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Unreadable Transcoding Job", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "job 0 (level 3)")
+	assert.False(t, ladder.IsNull())
+}
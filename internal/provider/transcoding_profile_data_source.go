@@ -28,7 +28,7 @@ var (
 // Data-source definition
 // --------------------------------------------------------------------
 type transcodingProfileDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 func NewTranscodingProfileDataSource() datasource.DataSource {
@@ -46,11 +46,11 @@ func (d *transcodingProfileDataSource) Configure(
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	c, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Provider Data Type",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got %T", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got %T", req.ProviderData),
 		)
 		return
 	}
@@ -78,19 +78,17 @@ func (d *transcodingProfileDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id": schema.Int64Attribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Computed: true,
-			},
-			// We keep raw JSON as a string for simplicity
+			"id":   lookupIDAttribute("transcoding profile"),
+			"name": lookupNameAttribute("transcoding profile"),
+			// Raw JSON, compared semantically
 			"content": schema.StringAttribute{
-				Computed: true,
+				CustomType: jsonStringType{},
+				Computed:   true,
 			},
 			"internal_id": schema.StringAttribute{
 				Computed: true,
 			},
+			"ladder": transcodingLadderAttribute(),
 		},
 	}
 }
@@ -103,30 +101,44 @@ func (d *transcodingProfileDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	// Parse ID from config
-	var id int64
-	diags := req.Config.GetAttribute(ctx, path.Root("id"), &id)
+	// Resolve ID from config
+	var id types.Int64
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID, diags := lookupID(ctx, id, name, "transcoding profile", d.client.namedTranscodingProfiles)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Fetch profile from API
-	p, err := d.client.GetTranscodingProfile(uint(id))
+	p, err := d.client.GetTranscodingProfile(ctx, profileID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Transcoding Profile",
-			fmt.Sprintf("Profile ID %d not found: %s", id, err),
+			fmt.Sprintf("Profile ID %d not found: %s", profileID, err),
 		)
 		return
 	}
 
+	ladder, diags := flattenTranscodingLadder(ctx, p.Id, p.Content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build state
-	state := transcodingProfileDataSourceModel{
+	state := transcodingProfileLadderDataSourceModel{
 		ID:         types.Int64Value(int64(p.Id)),
 		Name:       types.StringValue(p.Name),
-		Content:    types.StringValue(string(p.Content)),
+		Content:    newJSONString(string(p.Content)),
 		InternalId: types.StringValue(p.InternalId),
+		Ladder:     ladder,
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -139,8 +151,18 @@ func (d *transcodingProfileDataSource) Read(
 type transcodingProfileDataSourceModel struct {
 	ID         types.Int64  `tfsdk:"id"`
 	Name       types.String `tfsdk:"name"`
-	Content    types.String `tfsdk:"content"`
+	Content    jsonString   `tfsdk:"content"`
+	InternalId types.String `tfsdk:"internal_id"`
+}
+
+// transcodingProfileLadderDataSourceModel maps the transcoding profile data
+// source schema data, which adds the parsed ladder to the profile.
+type transcodingProfileLadderDataSourceModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Content    jsonString   `tfsdk:"content"`
 	InternalId types.String `tfsdk:"internal_id"`
+	Ladder     types.Object `tfsdk:"ladder"`
 }
 
 func FlattenTranscodingProfiles(list []broadpeakio.TranscodingProfile) ([]attr.Value, attr.Type, error) {
@@ -148,7 +170,7 @@ func FlattenTranscodingProfiles(list []broadpeakio.TranscodingProfile) ([]attr.V
 		AttrTypes: map[string]attr.Type{
 			"id":          types.Int64Type,
 			"name":        types.StringType,
-			"content":     types.StringType,
+			"content":     jsonStringType{},
 			"internal_id": types.StringType,
 		},
 	}
@@ -158,7 +180,7 @@ func FlattenTranscodingProfiles(list []broadpeakio.TranscodingProfile) ([]attr.V
 		obj, diag := types.ObjectValue(profileType.AttrTypes, map[string]attr.Value{
 			"id":          types.Int64Value(int64(p.Id)),
 			"name":        types.StringValue(p.Name),
-			"content":     types.StringValue(p.Content),
+			"content":     newJSONString(p.Content),
 			"internal_id": types.StringValue(p.InternalId),
 		})
 		if diag.HasError() {
@@ -3,10 +3,12 @@ package provider
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	broadpeakio "github.com/bashou/bpkio-go-sdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestFlattenTranscodingProfiles(t *testing.T) {
@@ -53,6 +55,8 @@ func TestFlattenTranscodingProfiles(t *testing.T) {
 				switch val := v.(type) {
 				case types.String:
 					m[k] = val.ValueString()
+				case jsonString:
+					m[k] = val.ValueString()
 				case types.Int64:
 					m[k] = fmt.Sprintf("%d", val.ValueInt64())
 				default:
@@ -67,3 +71,70 @@ func TestFlattenTranscodingProfiles(t *testing.T) {
 		}
 	}
 }
+
+func TestAccTranscodingProfileDataSource_Ladder(t *testing.T) {
+	apiKey := testAccAPIKey()
+	dataSourceName := "data.bpkio_transcoding_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTranscodingProfileConfig(apiKey, "tf-acc-profile-ladder", fmt.Sprintf(testAccProfileContent, "1500k")) + `
+data "bpkio_transcoding_profile" "test" {
+  id = bpkio_transcoding_profile.test.id
+}
+
+data "bpkio_transcoding_profiles" "all" {
+  depends_on = [bpkio_transcoding_profile.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.0.codec", "h264"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.0.bitrate", "1500k"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.0.resolution", "1280x720"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.0.width", "1280"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.video.0.framerate", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.audio.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.audio.0.bitrate", "128k"),
+					resource.TestCheckResourceAttr(dataSourceName, "ladder.packaging.--hls-client-manifest-version", "4"),
+					resource.TestCheckResourceAttrSet("data.bpkio_transcoding_profiles.all", "profiles.0.ladder.video.0.codec"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranscodingProfileDataSource_Name(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+data "bpkio_transcoding_profile" "test" {}
+`, apiKey),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: testAccTranscodingProfileConfig(apiKey, "tf-acc-profile-lookup", fmt.Sprintf(testAccProfileContent, "1500k")) + `
+data "bpkio_transcoding_profile" "test" {
+  name = bpkio_transcoding_profile.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bpkio_transcoding_profile.test", "id", "bpkio_transcoding_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.bpkio_transcoding_profile.test", "ladder.video.0.bitrate", "1500k"),
+				),
+			},
+		},
+	})
+}
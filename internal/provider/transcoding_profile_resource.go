@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &transcodingProfileResource{}
+	_ resource.ResourceWithConfigure   = &transcodingProfileResource{}
+	_ resource.ResourceWithImportState = &transcodingProfileResource{}
+)
+
+// NewTranscodingProfileResource is a helper function to simplify the provider implementation.
+func NewTranscodingProfileResource() resource.Resource {
+	return &transcodingProfileResource{}
+}
+
+// transcodingProfileResource is the resource implementation.
+type transcodingProfileResource struct {
+	client *bpkioClient
+}
+
+// transcodingProfileAPIFields maps the request fields the API may reject to
+// the attributes they are read from.
+var transcodingProfileAPIFields = map[string]path.Path{
+	"name":    path.Root("name"),
+	"content": path.Root("content"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *transcodingProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *transcodingProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transcoding_profile"
+}
+
+// Schema defines the schema for the resource.
+func (r *transcodingProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a transcoding profile, the ladder ads are transcoded to by services with ad transcoding enabled.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the transcoding profile.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the transcoding profile.",
+			},
+			"content": schema.StringAttribute{
+				CustomType: jsonStringType{},
+				Required:   true,
+				Description: "JSON document of the transcoding profile, with its `packaging`, `servicetype` and `transcoding` jobs. " +
+					"Formatting and key order are not significant: the API may reformat the document without causing a diff.",
+				Validators: []validator.String{
+					isJSONObject(),
+				},
+				PlanModifiers: []planmodifier.String{
+					jsonSemanticEquality{},
+				},
+			},
+			"internal_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal ID of the transcoding profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *transcodingProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan transcodingProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new transcoding profile
+	profile, err := r.client.CreateTranscodingProfile(ctx, expandTranscodingProfile(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating transcoding profile", "Could not create transcoding profile", err, transcodingProfileAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := flattenTranscodingProfile(profile)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *transcodingProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state transcodingProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.client.GetTranscodingProfile(ctx, uint(state.ID.ValueInt64()))
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Transcoding profile no longer exists, removing it from state", map[string]interface{}{"id": state.ID.ValueInt64()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Transcoding Profile",
+			fmt.Sprintf("Could not read transcoding profile ID %d: %s", state.ID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	// Set refreshed state
+	newState := flattenTranscodingProfile(profile)
+	newState.Timeouts = state.Timeouts
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *transcodingProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan transcodingProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileID := uint(plan.ID.ValueInt64())
+
+	// Update existing transcoding profile
+	profile, err := r.client.UpdateTranscodingProfile(ctx, profileID, expandTranscodingProfile(plan))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating transcoding profile", fmt.Sprintf("Could not update transcoding profile ID %d", profileID), err, transcodingProfileAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := flattenTranscodingProfile(profile)
+	state.Timeouts = plan.Timeouts
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *transcodingProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state transcodingProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing transcoding profile
+	_, err := r.client.DeleteTranscodingProfile(ctx, uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Transcoding Profile",
+			"Could not delete transcoding profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from the ID.
+func (r *transcodingProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing transcoding profile",
+			fmt.Sprintf("Invalid ID format: %s. Expected a numeric ID. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// expandTranscodingProfile builds the API request for a transcoding profile
+// from its model.
+func expandTranscodingProfile(m transcodingProfileResourceModel) transcodingProfileInput {
+	return transcodingProfileInput{
+		Name:    m.Name.ValueString(),
+		Content: m.Content.ValueString(),
+	}
+}
+
+// flattenTranscodingProfile maps a transcoding profile returned by the API to
+// its model.
+func flattenTranscodingProfile(p broadpeakio.TranscodingProfileOutput) transcodingProfileResourceModel {
+	return transcodingProfileResourceModel{
+		ID:         types.Int64Value(int64(p.Id)),
+		Name:       types.StringValue(p.Name),
+		Content:    newJSONString(p.Content),
+		InternalId: types.StringValue(p.InternalId),
+	}
+}
+
+// transcodingProfileResourceModel maps the transcoding profile resource schema
+// data.
+type transcodingProfileResourceModel struct {
+	ID         types.Int64    `tfsdk:"id"`
+	Name       types.String   `tfsdk:"name"`
+	Content    jsonString     `tfsdk:"content"`
+	InternalId types.String   `tfsdk:"internal_id"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// testAccProfileContent is a transcoding profile formatted the way people
+// write it, unlike the compact form the API returns.
+const testAccProfileContent = `{
+  "servicetype": "offline_transcoding",
+  "transcoding": {
+    "jobs": [
+      { "level": "0", "type": "video", "codecv": "h264", "bitratev": "%s", "scaling": "1280:720", "framerate": "25" },
+      { "level": "1", "type": "audio", "codeca": "aac", "bitratea": "128k" }
+    ]
+  },
+  "packaging": { "--hls-client-manifest-version": "4" }
+}`
+
+func TestAccTranscodingProfile_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_transcoding_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTranscodingProfileConfig(apiKey, "tf-acc-profile", fmt.Sprintf(testAccProfileContent, "1500k")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "internal_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-profile"),
+					resource.TestCheckResourceAttr(resourceName, "content", fmt.Sprintf(testAccProfileContent, "1500k")+"\n"),
+				),
+			},
+			{
+				// The API returns the content reformatted.
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			{
+				// The same document, compacted with other key order, is not a
+				// change.
+				Config:   testAccTranscodingProfileConfig(apiKey, "tf-acc-profile", `{"packaging":{"--hls-client-manifest-version":"4"},"transcoding":{"jobs":[{"type":"video","level":"0","codecv":"h264","bitratev":"1500k","scaling":"1280:720","framerate":"25"},{"type":"audio","level":"1","codeca":"aac","bitratea":"128k"}]},"servicetype":"offline_transcoding"}`),
+				PlanOnly: true,
+			},
+			{
+				// Change the ladder, in place.
+				Config: testAccTranscodingProfileConfig(apiKey, "tf-acc-profile", fmt.Sprintf(testAccProfileContent, "3000k")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "content", fmt.Sprintf(testAccProfileContent, "3000k")+"\n"),
+			},
+		},
+	})
+}
+
+func TestAccTranscodingProfile_InvalidContent(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTranscodingProfileConfig(apiKey, "tf-acc-profile", `{"transcoding": `),
+				ExpectError: regexp.MustCompile(`value must be a JSON object`),
+			},
+		},
+	})
+}
+
+func TestAccTranscodingProfile_Drift(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_transcoding_profile.test"
+	config := testAccTranscodingProfileConfig(apiKey, "tf-acc-profile", fmt.Sprintf(testAccProfileContent, "1500k"))
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				// The API reformatting the document is not drift.
+				PreConfig: func() {
+					testAccFakeAPI.SetProfileField(id, "content", fmt.Sprintf(testAccProfileContent, "1500k"))
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				// A ladder edited outside of Terraform is put back.
+				PreConfig: func() {
+					testAccFakeAPI.SetProfileField(id, "content", `{"servicetype":"offline_transcoding"}`)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.mu.Lock()
+					defer testAccFakeAPI.mu.Unlock()
+					delete(testAccFakeAPI.profiles, id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+// testAccTranscodingProfileConfig declares a transcoding profile with the
+// given JSON content.
+func testAccTranscodingProfileConfig(apiKey, name, content string) string {
+	return fmt.Sprintf(`
+provider "bpkio" {
+  api_key = "%s"
+}
+
+resource "bpkio_transcoding_profile" "test" {
+  name    = "%s"
+  content = <<-EOT
+%s
+EOT
+}
+`, apiKey, name, content)
+}
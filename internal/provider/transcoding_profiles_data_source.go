@@ -7,8 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	broadpeakio "github.com/bashou/bpkio-go-sdk"
-
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -27,7 +25,7 @@ var (
 // Data-source definition
 // --------------------------------------------------------------------
 type transcodingProfilesDataSource struct {
-	client *broadpeakio.BroadpeakClient
+	client *bpkioClient
 }
 
 func NewTranscodingProfilesDataSource() datasource.DataSource {
@@ -45,11 +43,11 @@ func (d *transcodingProfilesDataSource) Configure(
 	if req.ProviderData == nil {
 		return
 	}
-	c, ok := req.ProviderData.(*broadpeakio.BroadpeakClient)
+	c, ok := req.ProviderData.(*bpkioClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Provider Data",
-			fmt.Sprintf("Expected *broadpeakio.BroadpeakClient, got %T", req.ProviderData),
+			fmt.Sprintf("Expected *bpkioClient, got %T", req.ProviderData),
 		)
 		return
 	}
@@ -77,14 +75,16 @@ func (d *transcodingProfilesDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"max_results": maxResultsAttribute("transcoding profiles", "in API order"),
 			"profiles": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id":          schema.Int64Attribute{Computed: true},
 						"name":        schema.StringAttribute{Computed: true},
-						"content":     schema.StringAttribute{Computed: true},
+						"content":     schema.StringAttribute{CustomType: jsonStringType{}, Computed: true},
 						"internal_id": schema.StringAttribute{Computed: true},
+						"ladder":      transcodingLadderAttribute(),
 					},
 				},
 			},
@@ -97,35 +97,49 @@ func (d *transcodingProfilesDataSource) Schema(
 // --------------------------------------------------------------------
 func (d *transcodingProfilesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
+	var state transcodingProfilesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// 1. Call the Broadpeak API
-	list, err := d.client.GetAllTranscodingProfiles(0, 2000)
+	list, status, err := listAll(ctx, d.client.pageSize, int(state.MaxResults.ValueInt64()), d.client.GetAllTranscodingProfiles, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to List Transcoding Profiles", err.Error())
 		return
 	}
+	addListWarnings(&resp.Diagnostics, "transcoding profiles", state.MaxResults, status)
 
 	// 2. Build Terraform-typed list
 	profileObjType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
 			"id":          types.Int64Type,
 			"name":        types.StringType,
-			"content":     types.StringType,
+			"content":     jsonStringType{},
 			"internal_id": types.StringType,
+			"ladder":      types.ObjectType{AttrTypes: transcodingLadderAttrTypes},
 		},
 	}
 
 	var objs []attr.Value
 	for _, p := range list {
+		ladder, diag := flattenTranscodingLadder(ctx, p.Id, p.Content)
+		resp.Diagnostics.Append(diag...)
+		if diag.HasError() {
+			return
+		}
 		objVal, diag := types.ObjectValue(
 			profileObjType.AttrTypes,
 			map[string]attr.Value{
 				"id":          types.Int64Value(int64(p.Id)),
 				"name":        types.StringValue(p.Name),
-				"content":     types.StringValue(string(p.Content)), // Raw JSON → string
+				"content":     newJSONString(string(p.Content)), // Raw JSON, compared semantically
 				"internal_id": types.StringValue(p.InternalId),
+				"ladder":      ladder,
 			},
 		)
 		if diag.HasError() {
@@ -143,9 +157,7 @@ func (d *transcodingProfilesDataSource) Read(
 	}
 
 	// 3. Set state
-	state := transcodingProfilesDataSourceModel{
-		Profiles: profilesList,
-	}
+	state.Profiles = profilesList
 	diag := resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diag...)
 }
@@ -154,5 +166,6 @@ func (d *transcodingProfilesDataSource) Read(
 // State model
 // --------------------------------------------------------------------
 type transcodingProfilesDataSourceModel struct {
-	Profiles types.List `tfsdk:"profiles"` // List<Object>
+	MaxResults types.Int64 `tfsdk:"max_results"`
+	Profiles   types.List  `tfsdk:"profiles"` // List<Object>
 }
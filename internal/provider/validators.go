@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = rfc3339Validator{}
+	_ validator.String = jsonObjectValidator{}
+	_ validator.String = regexpValidator{}
+)
+
+// rfc3339Validator checks that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp, such as 2024-05-01T20:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// isRFC3339 returns a validator which ensures that a string is an RFC 3339
+// timestamp.
+func isRFC3339() validator.String {
+	return rfc3339Validator{}
+}
+
+// jsonObjectValidator checks that a string is a JSON object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil || obj == nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// isJSONObject returns a validator which ensures that a string is a JSON
+// object.
+func isJSONObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+// regexpValidator checks that a string is a regular expression.
+type regexpValidator struct{}
+
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid RE2 regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("%s (%s)", v.Description(ctx), err),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// isRegexp returns a validator which ensures that a string is a regular
+// expression.
+func isRegexp() validator.String {
+	return regexpValidator{}
+}
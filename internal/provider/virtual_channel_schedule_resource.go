@@ -0,0 +1,481 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &virtualChannelScheduleResource{}
+	_ resource.ResourceWithConfigure      = &virtualChannelScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &virtualChannelScheduleResource{}
+)
+
+// NewVirtualChannelScheduleResource is a helper function to simplify the provider implementation.
+func NewVirtualChannelScheduleResource() resource.Resource {
+	return &virtualChannelScheduleResource{}
+}
+
+// virtualChannelScheduleResource is the resource implementation. It owns
+// every slot of a virtual channel that starts within its window, and
+// reconciles them with the configured list on each apply.
+type virtualChannelScheduleResource struct {
+	client *bpkioClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *virtualChannelScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *virtualChannelScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_channel_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *virtualChannelScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages every slot of a virtual channel that starts within a time window. " +
+			"Slots of the window that are not configured are deleted; slots outside of it are left alone, " +
+			"except those the schedule held before its window moved, which are deleted.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the virtual channel. Changing it forces a new schedule to be created.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"window_start": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the window owned by the schedule, as an RFC 3339 timestamp.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"window_end": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the window owned by the schedule, as an RFC 3339 timestamp.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			"slots": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: virtualChannelSlotAttributes(),
+				},
+				Required:    true,
+				Description: "Slots of the window. Slots must start within the window and must not overlap.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig checks that every slot starts within the window and that no
+// two slots overlap.
+func (r *virtualChannelScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The slots may be unknown until apply, e.g. when built from other
+	// resources; they are validated again once known.
+	var slots types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("slots"), &slots)...)
+	if resp.Diagnostics.HasError() || slots.IsUnknown() {
+		return
+	}
+
+	var config virtualChannelScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowStart, startOK := knownTime(config.WindowStart)
+	windowEnd, endOK := knownTime(config.WindowEnd)
+	if startOK && endOK && !windowEnd.After(windowStart) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window_end"),
+			"Invalid Schedule Window",
+			fmt.Sprintf("window_end (%s) must be after window_start (%s).", config.WindowEnd.ValueString(), config.WindowStart.ValueString()),
+		)
+		return
+	}
+
+	type span struct {
+		index      int
+		start, end time.Time
+	}
+	var spans []span
+	for i, slot := range config.Slots {
+		start, ok := knownTime(slot.StartTime)
+		if !ok {
+			continue
+		}
+		if (startOK && start.Before(windowStart)) || (endOK && !start.Before(windowEnd)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slots").AtListIndex(i).AtName("start_time"),
+				"Slot Outside of Schedule Window",
+				fmt.Sprintf("The slot starts at %s, outside of the [%s, %s) window of the schedule.",
+					slot.StartTime.ValueString(), config.WindowStart.ValueString(), config.WindowEnd.ValueString()),
+			)
+		}
+		if slot.Duration.IsNull() || slot.Duration.IsUnknown() {
+			continue
+		}
+		spans = append(spans, span{i, start, start.Add(time.Duration(slot.Duration.ValueInt64()) * time.Second)})
+	}
+
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].start.Before(spans[j].start) })
+	for i := 1; i < len(spans); i++ {
+		prev, cur := spans[i-1], spans[i]
+		if cur.start.Before(prev.end) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slots").AtListIndex(cur.index),
+				"Overlapping Slots",
+				fmt.Sprintf("Slot %d (starting at %s) overlaps slot %d, which runs until %s.",
+					cur.index, cur.start.Format(time.RFC3339), prev.index, prev.end.Format(time.RFC3339)),
+			)
+		}
+	}
+}
+
+// knownTime parses a known RFC 3339 timestamp.
+func knownTime(v types.String) (time.Time, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+	return t, err == nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *virtualChannelScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan virtualChannelScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.reconcile(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *virtualChannelScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state virtualChannelScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(state.ServiceID.ValueInt64())
+	slots, err := r.windowSlots(ctx, state)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Virtual channel no longer exists, removing its schedule from state", map[string]interface{}{"service_id": serviceID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Virtual Channel Schedule",
+			fmt.Sprintf("Could not list the slots of virtual channel ID %d: %s", serviceID, err),
+		)
+		return
+	}
+
+	// Keep the order of the slots already in state, so that a refresh only
+	// reports actual changes; slots added out of band come last.
+	byID := make(map[uint]virtualChannelSlotOutput, len(slots))
+	for _, s := range slots {
+		byID[s.Id] = s
+	}
+	refreshed := make([]virtualChannelSlotModel, 0, len(slots))
+	for _, prior := range state.Slots {
+		if s, ok := byID[uint(prior.ID.ValueInt64())]; ok {
+			refreshed = append(refreshed, flattenVirtualChannelSlot(s, prior.StartTime))
+			delete(byID, s.Id)
+		}
+	}
+	for _, s := range slots {
+		if _, ok := byID[s.Id]; ok {
+			refreshed = append(refreshed, flattenVirtualChannelSlot(s, types.StringNull()))
+		}
+	}
+	state.Slots = refreshed
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *virtualChannelScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan virtualChannelScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The slots in state may lie outside of a moved window.
+	var prior virtualChannelScheduleResourceModel
+	diags = req.State.Get(ctx, &prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.reconcile(ctx, plan, prior.Slots)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *virtualChannelScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state virtualChannelScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(state.ServiceID.ValueInt64())
+	for _, slot := range state.Slots {
+		_, err := r.client.DeleteVirtualChannelSlot(ctx, serviceID, uint(slot.ID.ValueInt64()))
+		// A retried DELETE may find the object already gone.
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Virtual Channel Schedule",
+				fmt.Sprintf("Could not delete slot ID %d, unexpected error: %s", slot.ID.ValueInt64(), err),
+			)
+			return
+		}
+	}
+}
+
+// windowSlots lists the slots of the virtual channel that start within the
+// window of the schedule, in chronological order.
+func (r *virtualChannelScheduleResource) windowSlots(ctx context.Context, m virtualChannelScheduleResourceModel) ([]virtualChannelSlotOutput, error) {
+	windowStart, _ := knownTime(m.WindowStart)
+	windowEnd, _ := knownTime(m.WindowEnd)
+
+	slots, _, err := listAll(ctx, r.client.pageSize, 0, func(ctx context.Context, offset, limit uint) ([]virtualChannelSlotOutput, error) {
+		return r.client.GetVirtualChannelSlots(ctx, uint(m.ServiceID.ValueInt64()),
+			windowStart.UTC().Format(time.RFC3339), windowEnd.UTC().Format(time.RFC3339), offset, limit)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API returns the slots that overlap the window; only those that
+	// start within it belong to the schedule.
+	owned := make([]virtualChannelSlotOutput, 0, len(slots))
+	for _, s := range slots {
+		start, err := time.Parse(time.RFC3339, s.StartTime)
+		if err != nil || start.Before(windowStart) || !start.Before(windowEnd) {
+			continue
+		}
+		owned = append(owned, s)
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		a, _ := time.Parse(time.RFC3339, owned[i].StartTime)
+		b, _ := time.Parse(time.RFC3339, owned[j].StartTime)
+		return a.Before(b)
+	})
+	return owned, nil
+}
+
+// reconcile brings the slots of the window in line with the plan. Existing
+// slots are matched to planned ones by start time: unmatched slots are
+// deleted first, so that the remaining ones never overlap, then matched
+// slots are updated where they differ and the missing ones are created.
+// prior holds the slots of the schedule before the change; those left out
+// of the planned window, when the window moves, are deleted too.
+func (r *virtualChannelScheduleResource) reconcile(ctx context.Context, plan virtualChannelScheduleResourceModel, prior []virtualChannelSlotModel) (virtualChannelScheduleResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	serviceID := uint(plan.ServiceID.ValueInt64())
+
+	existing, err := r.windowSlots(ctx, plan)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Virtual Channel Schedule",
+			fmt.Sprintf("Could not list the slots of virtual channel ID %d: %s", serviceID, err),
+		)
+		return plan, diags
+	}
+
+	byStart := make(map[int64]virtualChannelSlotOutput, len(existing))
+	for _, s := range existing {
+		start, _ := time.Parse(time.RFC3339, s.StartTime)
+		byStart[start.Unix()] = s
+	}
+	matched := make([]*virtualChannelSlotOutput, len(plan.Slots))
+	for i, slot := range plan.Slots {
+		start, _ := knownTime(slot.StartTime)
+		if s, ok := byStart[start.Unix()]; ok {
+			matched[i] = &s
+			delete(byStart, start.Unix())
+		}
+	}
+
+	var stale []uint
+	listed := make(map[uint]bool, len(existing))
+	for _, s := range existing {
+		listed[s.Id] = true
+		start, _ := time.Parse(time.RFC3339, s.StartTime)
+		if _, ok := byStart[start.Unix()]; ok {
+			stale = append(stale, s.Id)
+		}
+	}
+	// Prior slots that do not start within the planned window are no longer
+	// owned by the schedule.
+	for _, slot := range prior {
+		if id := uint(slot.ID.ValueInt64()); !slot.ID.IsNull() && !slot.ID.IsUnknown() && !listed[id] {
+			stale = append(stale, id)
+		}
+	}
+
+	for _, id := range stale {
+		tflog.Debug(ctx, "Deleting virtual channel slot", map[string]interface{}{"service_id": serviceID, "id": id})
+		if _, err := r.client.DeleteVirtualChannelSlot(ctx, serviceID, id); err != nil && !isNotFound(err) {
+			diags.AddError(
+				"Error Deleting Virtual Channel Slot",
+				fmt.Sprintf("Could not delete slot ID %d of virtual channel ID %d: %s", id, serviceID, err),
+			)
+			return plan, diags
+		}
+	}
+
+	state := plan
+	state.Slots = make([]virtualChannelSlotModel, len(plan.Slots))
+	for i, slot := range plan.Slots {
+		s := matched[i]
+		if s == nil {
+			continue
+		}
+		if in := expandVirtualChannelSlot(slot); slotDiffers(*s, in) {
+			tflog.Debug(ctx, "Updating virtual channel slot", map[string]interface{}{"service_id": serviceID, "id": s.Id})
+			updated, err := r.client.UpdateVirtualChannelSlot(ctx, serviceID, s.Id, in)
+			if err != nil {
+				addAPIError(&diags, "Error updating Virtual-Channel slot", fmt.Sprintf("Could not update slot ID %d of virtual channel ID %d", s.Id, serviceID), err, scheduleSlotAPIFields(i))
+				return plan, diags
+			}
+			s = &updated
+		}
+		state.Slots[i] = flattenVirtualChannelSlot(*s, slot.StartTime)
+	}
+
+	for i, slot := range plan.Slots {
+		if matched[i] != nil {
+			continue
+		}
+		tflog.Debug(ctx, "Creating virtual channel slot", map[string]interface{}{"service_id": serviceID, "start_time": slot.StartTime.ValueString()})
+		created, err := r.client.CreateVirtualChannelSlot(ctx, serviceID, expandVirtualChannelSlot(slot))
+		if err != nil {
+			addAPIError(&diags, "Error creating Virtual-Channel slot", fmt.Sprintf("Could not create slot on virtual channel ID %d", serviceID), err, scheduleSlotAPIFields(i))
+			return plan, diags
+		}
+		state.Slots[i] = flattenVirtualChannelSlot(created, slot.StartTime)
+	}
+
+	return state, diags
+}
+
+// slotDiffers reports whether an existing slot must be updated to match the
+// request.
+func slotDiffers(s virtualChannelSlotOutput, in virtualChannelSlotInput) bool {
+	return s.Name != in.Name ||
+		s.Duration != in.Duration ||
+		s.Replacement.Id != in.Replacement.Id ||
+		s.Replay != in.Replay
+}
+
+// scheduleSlotAPIFields maps the request fields the API may reject to the
+// attributes of the i-th slot of the schedule.
+func scheduleSlotAPIFields(i int) map[string]path.Path {
+	slot := path.Root("slots").AtListIndex(i)
+	return map[string]path.Path{
+		"name":           slot.AtName("name"),
+		"startTime":      slot.AtName("start_time"),
+		"duration":       slot.AtName("duration"),
+		"replacement":    slot.AtName("source"),
+		"replacement.id": slot.AtName("source").AtName("id"),
+		"replay":         slot.AtName("replay"),
+	}
+}
+
+// virtualChannelScheduleResourceModel maps the schedule resource schema data.
+type virtualChannelScheduleResourceModel struct {
+	ServiceID   types.Int64               `tfsdk:"service_id"`
+	WindowStart types.String              `tfsdk:"window_start"`
+	WindowEnd   types.String              `tfsdk:"window_end"`
+	Slots       []virtualChannelSlotModel `tfsdk:"slots"`
+	Timeouts    timeouts.Value            `tfsdk:"timeouts"`
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVirtualChannelSchedule_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_virtual_channel_schedule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualChannelScheduleConfig(apiKey,
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T10:00:00Z", 3600, ""),
+					testAccScheduleSlot("bpkio_source_asset.asset", "2030-01-01T13:00:00+02:00", 1800, `replay = true`),
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T12:00:00Z", 3600, `name = "evening"`),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slots.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "slots.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "slots.0.end_time", "2030-01-01T11:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "slots.1.start_time", "2030-01-01T13:00:00+02:00"),
+					resource.TestCheckResourceAttr(resourceName, "slots.1.replay", "true"),
+					resource.TestCheckResourceAttr(resourceName, "slots.1.source.type", "asset"),
+					resource.TestCheckResourceAttr(resourceName, "slots.2.name", "evening"),
+				),
+			},
+			{
+				// Drop the first slot, lengthen the second and add a new one:
+				// the slot starting at 12:00 is kept as is.
+				Config: testAccVirtualChannelScheduleConfig(apiKey,
+					testAccScheduleSlot("bpkio_source_asset.asset", "2030-01-01T13:00:00+02:00", 3600, `replay = true`),
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T12:00:00Z", 3600, `name = "evening"`),
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T14:00:00Z", 600, ""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slots.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "slots.0.end_time", "2030-01-01T12:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "slots.2.end_time", "2030-01-01T14:10:00.000Z"),
+					testAccCheckScheduleSlotCount(3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSchedule_OverlappingSlots(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualChannelScheduleConfig(apiKey,
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T10:00:00Z", 3600, ""),
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T12:30:00+02:00", 600, ""),
+				),
+				ExpectError: regexp.MustCompile(`Overlapping Slots`),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSchedule_SlotOutsideWindow(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualChannelScheduleConfig(apiKey,
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-02T10:00:00Z", 3600, ""),
+				),
+				ExpectError: regexp.MustCompile(`Slot Outside of Schedule Window`),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSchedule_Drift(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_virtual_channel_schedule.test"
+	config := testAccVirtualChannelScheduleConfig(apiKey,
+		testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T10:00:00Z", 3600, ""),
+		testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T12:00:00Z", 3600, ""),
+	)
+	var slotID uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureAttrID(resourceName, "slots.1.id", &slotID),
+			},
+			{
+				// A slot changed out of band is put back.
+				PreConfig: func() {
+					testAccFakeAPI.SetSlotField(slotID, "name", "changed")
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr(resourceName, "slots.1.name", ""),
+			},
+			{
+				// A slot deleted out of band is created again.
+				PreConfig: func() {
+					testAccFakeAPI.DeleteSlot(slotID)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slots.#", "2"),
+					testAccCheckScheduleSlotCount(2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSchedule_MoveWindow(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_virtual_channel_schedule.test"
+	var firstID, secondID uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualChannelScheduleConfig(apiKey,
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T10:00:00Z", 3600, ""),
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-01T12:00:00Z", 3600, ""),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCaptureAttrID(resourceName, "slots.0.id", &firstID),
+					testAccCaptureAttrID(resourceName, "slots.1.id", &secondID),
+				),
+			},
+			{
+				// The slots of the previous window are deleted rather than
+				// left behind on the channel.
+				Config: testAccVirtualChannelScheduleWindowConfig(apiKey, "2030-01-02T00:00:00Z", "2030-01-03T00:00:00Z",
+					testAccScheduleSlot("bpkio_source_live.other", "2030-01-02T10:00:00Z", 3600, ""),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slots.#", "1"),
+					testAccCheckScheduleSlotCount(1),
+					func(_ *terraform.State) error {
+						testAccFakeAPI.mu.Lock()
+						defer testAccFakeAPI.mu.Unlock()
+						for _, id := range []uint{firstID, secondID} {
+							if _, ok := testAccFakeAPI.slots[id]; ok {
+								return fmt.Errorf("slot %d of the previous window is still on the API", id)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckScheduleSlotCount checks the number of slots the fake API holds.
+func testAccCheckScheduleSlotCount(n int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if testAccFakeAPI == nil {
+			return nil
+		}
+		testAccFakeAPI.mu.Lock()
+		defer testAccFakeAPI.mu.Unlock()
+		if len(testAccFakeAPI.slots) != n {
+			return fmt.Errorf("expected %d slots on the API, got %d", n, len(testAccFakeAPI.slots))
+		}
+		return nil
+	}
+}
+
+// testAccScheduleSlot renders one element of the slots of a schedule.
+func testAccScheduleSlot(source, startTime string, duration int, extra string) string {
+	return fmt.Sprintf(`
+    {
+      source     = { id = %s.id }
+      start_time = "%s"
+      duration   = %d
+      %s
+    },`, source, startTime, duration, extra)
+}
+
+// testAccVirtualChannelScheduleConfig declares a schedule of the given slots
+// over the first day of 2030.
+func testAccVirtualChannelScheduleConfig(apiKey string, slots ...string) string {
+	return testAccVirtualChannelScheduleWindowConfig(apiKey, "2030-01-01T00:00:00Z", "2030-01-02T00:00:00Z", slots...)
+}
+
+// testAccVirtualChannelScheduleWindowConfig declares a schedule of the given
+// slots over the given window.
+func testAccVirtualChannelScheduleWindowConfig(apiKey, windowStart, windowEnd string, slots ...string) string {
+	return testAccVirtualChannelSlotSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_virtual_channel_schedule" "test" {
+  service_id   = bpkio_service_virtual_channel.test.id
+  window_start = "%s"
+  window_end   = "%s"
+
+  slots = [%s
+  ]
+}
+`, windowStart, windowEnd, strings.Join(slots, ""))
+}
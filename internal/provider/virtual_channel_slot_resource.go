@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	broadpeakio "github.com/bashou/bpkio-go-sdk"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualChannelSlotResource{}
+	_ resource.ResourceWithConfigure   = &virtualChannelSlotResource{}
+	_ resource.ResourceWithImportState = &virtualChannelSlotResource{}
+)
+
+// NewVirtualChannelSlotResource is a helper function to simplify the provider implementation.
+func NewVirtualChannelSlotResource() resource.Resource {
+	return &virtualChannelSlotResource{}
+}
+
+// virtualChannelSlotResource is the resource implementation.
+type virtualChannelSlotResource struct {
+	client *bpkioClient
+}
+
+// virtualChannelSlotAPIFields maps the request fields the API may reject to
+// the attributes they are read from.
+var virtualChannelSlotAPIFields = map[string]path.Path{
+	"name":           path.Root("name"),
+	"startTime":      path.Root("start_time"),
+	"duration":       path.Root("duration"),
+	"replacement":    path.Root("source"),
+	"replacement.id": path.Root("source").AtName("id"),
+	"replay":         path.Root("replay"),
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *virtualChannelSlotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*bpkioClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *bpkioClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *virtualChannelSlotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_channel_slot"
+}
+
+// Schema defines the schema for the resource.
+func (r *virtualChannelSlotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := virtualChannelSlotAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Computed:    true,
+		Description: "ID of the slot.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["service_id"] = schema.Int64Attribute{
+		Required:    true,
+		Description: "ID of the virtual channel the slot is scheduled on. Changing it forces a new slot to be created.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a slot of a virtual channel, a live or asset source played for a given time. " +
+			"Use `bpkio_virtual_channel_schedule` to manage every slot of a time window at once.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// virtualChannelSlotAttributes returns the attributes of a slot, shared by the
+// slot and the schedule resources.
+func virtualChannelSlotAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "ID of the slot.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Name of the slot.",
+			Default:     stringdefault.StaticString(""),
+		},
+		"source": schema.SingleNestedAttribute{
+			Attributes:  serviceRefLiteAttributes("live or asset source"),
+			Required:    true,
+			Description: "Live or asset source played during the slot.",
+		},
+		"start_time": schema.StringAttribute{
+			Required:    true,
+			Description: "Start of the slot, as an RFC 3339 timestamp.",
+			Validators: []validator.String{
+				isRFC3339(),
+			},
+		},
+		"duration": schema.Int64Attribute{
+			Required:    true,
+			Description: "Duration of the slot, in seconds.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"end_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "End of the slot, as computed by the API.",
+		},
+		"replay": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether an asset source starts over when it ends before the slot does (Default: `false`).",
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *virtualChannelSlotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan virtualChannelSlotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(plan.ServiceID.ValueInt64())
+
+	// Create new slot
+	slot, err := r.client.CreateVirtualChannelSlot(ctx, serviceID, expandVirtualChannelSlot(plan.slot()))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error creating Virtual-Channel slot", fmt.Sprintf("Could not create slot on virtual channel ID %d", serviceID), err, virtualChannelSlotAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := newVirtualChannelSlotResourceModel(plan, flattenVirtualChannelSlot(slot, plan.StartTime))
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *virtualChannelSlotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state virtualChannelSlotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(state.ServiceID.ValueInt64())
+	slotID := uint(state.ID.ValueInt64())
+
+	slot, err := r.client.GetVirtualChannelSlot(ctx, serviceID, slotID)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Virtual channel slot no longer exists, removing it from state", map[string]interface{}{"service_id": serviceID, "id": slotID})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Virtual Channel Slot",
+			fmt.Sprintf("Could not read slot ID %d of virtual channel ID %d: %s", slotID, serviceID, err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state = newVirtualChannelSlotResourceModel(state, flattenVirtualChannelSlot(slot, state.StartTime))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *virtualChannelSlotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan virtualChannelSlotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := uint(plan.ServiceID.ValueInt64())
+	slotID := uint(plan.ID.ValueInt64())
+
+	// Update existing slot
+	slot, err := r.client.UpdateVirtualChannelSlot(ctx, serviceID, slotID, expandVirtualChannelSlot(plan.slot()))
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Error updating Virtual-Channel slot", fmt.Sprintf("Could not update slot ID %d of virtual channel ID %d", slotID, serviceID), err, virtualChannelSlotAPIFields)
+		return
+	}
+
+	// Set state to fully populated data
+	state := newVirtualChannelSlotResourceModel(plan, flattenVirtualChannelSlot(slot, plan.StartTime))
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *virtualChannelSlotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state virtualChannelSlotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing slot
+	_, err := r.client.DeleteVirtualChannelSlot(ctx, uint(state.ServiceID.ValueInt64()), uint(state.ID.ValueInt64()))
+	// A retried DELETE may find the object already gone.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Virtual Channel Slot",
+			"Could not delete slot, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the resource state from a <service_id>/<slot_id> ID.
+func (r *virtualChannelSlotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceID, slotID, err := parseServiceChildID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing virtual channel slot",
+			fmt.Sprintf("Invalid ID format: %s. Expected <service_id>/<slot_id>. Error: %s", req.ID, err),
+		)
+		return
+	}
+
+	// Read is called automatically after the import to refresh the state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), slotID)...)
+}
+
+// parseServiceChildID splits the <service_id>/<id> import ID of an object
+// that belongs to a service.
+func parseServiceChildID(id string) (int64, int64, error) {
+	serviceID, childID, ok := strings.Cut(id, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("missing separator")
+	}
+	parent, err := strconv.ParseInt(serviceID, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	child, err := strconv.ParseInt(childID, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return parent, child, nil
+}
+
+// expandVirtualChannelSlot builds the API request for a slot from its model.
+func expandVirtualChannelSlot(m virtualChannelSlotModel) virtualChannelSlotInput {
+	return virtualChannelSlotInput{
+		CreateVirtualChannelSlotInput: broadpeakio.CreateVirtualChannelSlotInput{
+			Name:        m.Name.ValueString(),
+			StartTime:   m.StartTime.ValueString(),
+			Duration:    uint(m.Duration.ValueInt64()),
+			Replacement: &broadpeakio.Identifiable{Id: uint(m.Source.ID.ValueInt64())},
+		},
+		Replay: m.Replay.ValueBool(),
+	}
+}
+
+// flattenVirtualChannelSlot maps a slot returned by the API to its model. The
+// API normalizes timestamps to UTC, so the configured start time is kept when
+// it denotes the same instant.
+func flattenVirtualChannelSlot(s virtualChannelSlotOutput, priorStartTime types.String) virtualChannelSlotModel {
+	startTime := types.StringValue(s.StartTime)
+	if sameInstant(priorStartTime.ValueString(), s.StartTime) {
+		startTime = priorStartTime
+	}
+
+	return virtualChannelSlotModel{
+		ID:   types.Int64Value(int64(s.Id)),
+		Name: types.StringValue(s.Name),
+		Source: &sourceRefModel{
+			ID:   types.Int64Value(int64(s.Replacement.Id)),
+			Name: types.StringValue(s.Replacement.Name),
+			Type: types.StringValue(s.Replacement.Type),
+			URL:  types.StringValue(s.Replacement.Url),
+		},
+		StartTime: startTime,
+		Duration:  types.Int64Value(int64(s.Duration)),
+		EndTime:   types.StringValue(s.EndTime),
+		Replay:    types.BoolValue(s.Replay),
+	}
+}
+
+// sameInstant reports whether two RFC 3339 timestamps denote the same instant.
+func sameInstant(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
+
+// virtualChannelSlotResourceModel maps the slot resource schema data.
+type virtualChannelSlotResourceModel struct {
+	ID        types.Int64     `tfsdk:"id"`
+	ServiceID types.Int64     `tfsdk:"service_id"`
+	Name      types.String    `tfsdk:"name"`
+	Source    *sourceRefModel `tfsdk:"source"`
+	StartTime types.String    `tfsdk:"start_time"`
+	Duration  types.Int64     `tfsdk:"duration"`
+	EndTime   types.String    `tfsdk:"end_time"`
+	Replay    types.Bool      `tfsdk:"replay"`
+	Timeouts  timeouts.Value  `tfsdk:"timeouts"`
+}
+
+// newVirtualChannelSlotResourceModel returns the state of the slot s. The
+// service ID and timeouts come from prior.
+func newVirtualChannelSlotResourceModel(prior virtualChannelSlotResourceModel, s virtualChannelSlotModel) virtualChannelSlotResourceModel {
+	return virtualChannelSlotResourceModel{
+		ID:        s.ID,
+		ServiceID: prior.ServiceID,
+		Name:      s.Name,
+		Source:    s.Source,
+		StartTime: s.StartTime,
+		Duration:  s.Duration,
+		EndTime:   s.EndTime,
+		Replay:    s.Replay,
+		Timeouts:  prior.Timeouts,
+	}
+}
+
+// slot returns the slot attributes of the resource.
+func (m virtualChannelSlotResourceModel) slot() virtualChannelSlotModel {
+	return virtualChannelSlotModel{
+		ID:        m.ID,
+		Name:      m.Name,
+		Source:    m.Source,
+		StartTime: m.StartTime,
+		Duration:  m.Duration,
+		EndTime:   m.EndTime,
+		Replay:    m.Replay,
+	}
+}
+
+// virtualChannelSlotModel maps a slot, as managed by the slot and the
+// schedule resources.
+type virtualChannelSlotModel struct {
+	ID        types.Int64     `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Source    *sourceRefModel `tfsdk:"source"`
+	StartTime types.String    `tfsdk:"start_time"`
+	Duration  types.Int64     `tfsdk:"duration"`
+	EndTime   types.String    `tfsdk:"end_time"`
+	Replay    types.Bool      `tfsdk:"replay"`
+}
+
+// sourceRefModel maps a source referenced by ID, with its name, type and URL.
+type sourceRefModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+	URL  types.String `tfsdk:"url"`
+}
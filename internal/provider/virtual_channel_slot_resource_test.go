@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseServiceChildID(t *testing.T) {
+	serviceID, childID, err := parseServiceChildID("12/345")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), serviceID)
+	assert.Equal(t, int64(345), childID)
+
+	for _, id := range []string{"12", "12/", "/345", "a/345", "12/b"} {
+		_, _, err := parseServiceChildID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestSameInstant(t *testing.T) {
+	assert.True(t, sameInstant("2030-01-01T12:00:00+02:00", "2030-01-01T10:00:00.000Z"))
+	assert.False(t, sameInstant("2030-01-01T12:00:00Z", "2030-01-01T10:00:00.000Z"))
+	assert.False(t, sameInstant("", "2030-01-01T10:00:00.000Z"))
+}
+
+func TestAccVirtualChannelSlot_Basic(t *testing.T) {
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_virtual_channel_slot.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The API returns start times in UTC; the configured offset
+				// must not show up as a diff.
+				Config: testAccVirtualChannelSlotConfig(apiKey, "bpkio_source_live.other", "2030-01-01T12:00:00+02:00", 3600, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "bpkio_service_virtual_channel.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", ""),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2030-01-01T12:00:00+02:00"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2030-01-01T11:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "replay", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.id", "bpkio_source_live.other", "id"),
+					resource.TestCheckResourceAttr(resourceName, "source.type", "live"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccSlotImportID(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"start_time"},
+			},
+			{
+				// Switch to a replayed asset, in place.
+				Config: testAccVirtualChannelSlotConfig(apiKey, "bpkio_source_asset.asset", "2030-01-01T12:00:00+02:00", 7200, `
+  name   = "movie"
+  replay = true
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "movie"),
+					resource.TestCheckResourceAttr(resourceName, "duration", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", "2030-01-01T12:00:00.000Z"),
+					resource.TestCheckResourceAttr(resourceName, "replay", "true"),
+					resource.TestCheckResourceAttr(resourceName, "source.type", "asset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSlot_InvalidSource(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// Slots play live or asset sources, not slates.
+				Config:      testAccVirtualChannelSlotConfig(apiKey, "bpkio_source_slate.slate", "2030-01-01T10:00:00Z", 3600, ""),
+				ExpectError: regexp.MustCompile(`(?i)403|forbidden|not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSlot_InvalidStartTime(t *testing.T) {
+	apiKey := testAccAPIKey()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVirtualChannelSlotConfig(apiKey, "bpkio_source_live.other", "2030-01-01 10:00", 3600, ""),
+				ExpectError: regexp.MustCompile(`RFC 3339`),
+			},
+		},
+	})
+}
+
+func TestAccVirtualChannelSlot_DeletedOutOfBand(t *testing.T) {
+	testAccRequireFakeAPI(t)
+	apiKey := testAccAPIKey()
+	resourceName := "bpkio_virtual_channel_slot.test"
+	config := testAccVirtualChannelSlotConfig(apiKey, "bpkio_source_live.other", "2030-01-01T10:00:00Z", 3600, "")
+	var id uint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCaptureID(resourceName, &id),
+			},
+			{
+				PreConfig: func() {
+					testAccFakeAPI.DeleteSlot(id)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(resourceName, "id"),
+			},
+		},
+	})
+}
+
+// testAccSlotImportID builds the <service_id>/<slot_id> import ID of a
+// slot.
+func testAccSlotImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes["service_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+// testAccVirtualChannelSlotSources declares a virtual channel and the sources
+// its slots can play.
+func testAccVirtualChannelSlotSources(apiKey string) string {
+	return testAccServiceVirtualChannelSources(apiKey) + `
+resource "bpkio_source_asset" "asset" {
+  name = "tf-acc-vc-asset"
+  url  = "https://bpkiosamples.s3.eu-west-1.amazonaws.com/vod/bbb/bbb.m3u8"
+}
+
+resource "bpkio_service_virtual_channel" "test" {
+  name = "tf-acc-virtual-channel-slots"
+
+  base_live = {
+    id = bpkio_source_live.live.id
+  }
+}
+`
+}
+
+// testAccVirtualChannelSlotConfig schedules source on the virtual channel;
+// extra is added to the body of the slot.
+func testAccVirtualChannelSlotConfig(apiKey, source, startTime string, duration int, extra string) string {
+	return testAccVirtualChannelSlotSources(apiKey) + fmt.Sprintf(`
+resource "bpkio_virtual_channel_slot" "test" {
+  service_id = bpkio_service_virtual_channel.test.id
+  start_time = "%s"
+  duration   = %d
+
+  source = {
+    id = %s.id
+  }
+%s
+}
+`, startTime, duration, source, extra)
+}